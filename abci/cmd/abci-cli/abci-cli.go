@@ -5,7 +5,6 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"github.com/tendermint/tendermint/abci/example/kvstore"
 	"io"
 	"os"
 	"strings"
@@ -45,7 +44,8 @@ var (
 	flagProve  bool
 
 	// kvstore
-	flagPersist string
+	flagPersist      string
+	flagRetainBlocks int64
 )
 
 var RootCmd = &cobra.Command{
@@ -54,6 +54,11 @@ var RootCmd = &cobra.Command{
 	Long:  "the ABCI CLI tool wraps an ABCI client and is used for testing ABCI servers",
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 
+		// tenderlic_kvstore transactions are broadcast to a tendermint node
+		if cmd.HasParent() && cmd.Parent() == tlKVstoreCmd {
+			return nil
+		}
+
 		switch cmd.Use {
 		case "tenderlic_kvstore":
 			return nil
@@ -134,6 +139,11 @@ func addQueryFlags() {
 
 func addTLKVStoreFlags() {
 	tlKVstoreCmd.PersistentFlags().StringVarP(&flagPersist, "persist", "", "", "directory to use for a database")
+	tlKVstoreCmd.PersistentFlags().Int64VarP(&flagRetainBlocks,
+		"retain-blocks",
+		"",
+		0,
+		"number of blocks tendermint keeps after each commit, pruning older ones (0 keeps all)")
 }
 
 func addCommands() {
@@ -152,6 +162,8 @@ func addCommands() {
 
 	// Applications
 	addTLKVStoreFlags()
+	addTLKVStoreTxCommands()
+	addTLKVStoreExportCommand()
 	RootCmd.AddCommand(tlKVstoreCmd)
 }
 
@@ -614,10 +626,14 @@ func cmdKVStore(cmd *cobra.Command, args []string) error {
 	// Create the application - in memory or persisted to disk
 	var app types.Application
 	if flagPersist == "" {
-		app = tenderlic_kvstore.NewApplication()
+		memApp := tenderlic_kvstore.NewApplication()
+		memApp.RetainBlocks = flagRetainBlocks
+		app = memApp
 	} else {
-		app = kvstore.NewPersistentKVStoreApplication(flagPersist)
-		app.(*kvstore.PersistentKVStoreApplication).SetLogger(logger.With("module", "tenderlic_kvstore"))
+		persistentApp := tenderlic_kvstore.NewPersistentKVStoreApplication(flagPersist)
+		persistentApp.SetLogger(logger.With("module", "tenderlic_kvstore"))
+		persistentApp.SetRetainBlocks(flagRetainBlocks)
+		app = persistentApp
 	}
 
 	// Start the listener
@@ -0,0 +1,343 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/abci/example/tenderlic_kvstore"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/p2p"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// tenderlic_kvstore transactions
+var (
+	flagKeyFile string
+	flagNode    string
+	flagNonce   int64
+)
+
+var tlMintCmd = &cobra.Command{
+	Use:   "mint",
+	Short: "propose to mint tokens on the balance of a meter",
+	Long:  "propose to mint tokens on the balance of a meter: mint <meter> <amount>",
+	Args:  cobra.ExactArgs(2),
+	RunE:  cmdTLMint,
+}
+
+var tlTransferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "transfer tokens to a meter",
+	Long:  "transfer tokens to a meter: transfer <recipient> <amount>",
+	Args:  cobra.ExactArgs(2),
+	RunE:  cmdTLTransfer,
+}
+
+var tlBurnCmd = &cobra.Command{
+	Use:   "burn",
+	Short: "burn tokens of the sender",
+	Long:  "burn tokens of the sender: burn <amount>",
+	Args:  cobra.ExactArgs(1),
+	RunE:  cmdTLBurn,
+}
+
+var tlSetAdminsCmd = &cobra.Command{
+	Use:   "set_admins",
+	Short: "propose to set the community admins",
+	Long:  "propose to set the community admins and the approvals threshold: set_admins <address1,address2,...> <threshold>",
+	Args:  cobra.ExactArgs(2),
+	RunE:  cmdTLSetAdmins,
+}
+
+var tlSetAllowedMetersCmd = &cobra.Command{
+	Use:   "set_allowed_meters",
+	Short: "propose to set the meters allowed in the community",
+	Long:  "propose to set the meters allowed in the community: set_allowed_meters <meter1 pubkey> <meter2 pubkey> ...",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  cmdTLSetAllowedMeters,
+}
+
+var tlSetValidatorCmd = &cobra.Command{
+	Use:   "set_validator",
+	Short: "propose to add, update or remove (power 0) a validator",
+	Long:  "propose to add, update or remove (power 0) a validator: set_validator <base64 ed25519 pubkey> <power>",
+	Args:  cobra.ExactArgs(2),
+	RunE:  cmdTLSetValidator,
+}
+
+var tlSetQueryPolicyCmd = &cobra.Command{
+	Use:   "set_query_policy",
+	Short: "propose to set who can read the results of a query path",
+	Long:  "propose to set who can read the results of a query path: set_query_policy <path> <public|meters|owner|admins>",
+	Args:  cobra.ExactArgs(2),
+	RunE:  cmdTLSetQueryPolicy,
+}
+
+var tlApproveCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "approve a pending proposal",
+	Long:  "approve a pending proposal: approve <proposal id>",
+	Args:  cobra.ExactArgs(1),
+	RunE:  cmdTLApprove,
+}
+
+var tlExecuteCmd = &cobra.Command{
+	Use:   "execute",
+	Short: "execute a proposal approved by the threshold of admins",
+	Long:  "execute a proposal approved by the threshold of admins: execute <proposal id>",
+	Args:  cobra.ExactArgs(1),
+	RunE:  cmdTLExecute,
+}
+
+var tlMeasureCmd = &cobra.Command{
+	Use:   "measure",
+	Short: "store a power measure of the sender meter",
+	Long:  "store a power measure of the sender meter: measure <unix timestamp> <value>",
+	Args:  cobra.ExactArgs(2),
+	RunE:  cmdTLMeasure,
+}
+
+var tlQueryCmd = &cobra.Command{
+	Use:   "query",
+	Short: "query the application state with a signed query",
+	Long:  "query the application state with a query signed at the last height: query <path> <data>",
+	Args:  cobra.ExactArgs(2),
+	RunE:  cmdTLQuery,
+}
+
+var tlTxCmds = []*cobra.Command{
+	tlMintCmd,
+	tlTransferCmd,
+	tlBurnCmd,
+	tlSetAdminsCmd,
+	tlSetAllowedMetersCmd,
+	tlSetValidatorCmd,
+	tlSetQueryPolicyCmd,
+	tlApproveCmd,
+	tlExecuteCmd,
+	tlMeasureCmd,
+}
+
+func addTLKVStoreTxCommands() {
+	for _, cmd := range tlTxCmds {
+		cmd.Flags().StringVarP(&flagKeyFile,
+			"key",
+			"",
+			"tenderlic_key.json",
+			"file of the key signing the transaction (e.g. created by tendermint gen_node_key)")
+		cmd.Flags().StringVarP(&flagNode, "node", "", "tcp://0.0.0.0:26657", "address of the tendermint RPC server")
+		cmd.Flags().Int64VarP(&flagNonce, "nonce", "", -1, "nonce of the transaction, queried from the node if negative")
+		tlKVstoreCmd.AddCommand(cmd)
+	}
+
+	tlQueryCmd.Flags().StringVarP(&flagKeyFile, "key", "", "tenderlic_key.json", "file of the key signing the query")
+	tlQueryCmd.Flags().StringVarP(&flagNode, "node", "", "tcp://0.0.0.0:26657", "address of the tendermint RPC server")
+	tlKVstoreCmd.AddCommand(tlQueryCmd)
+}
+
+func cmdTLMint(cmd *cobra.Command, args []string) error {
+	amount, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return err
+	}
+	return broadcastLictTx(cmd, args, proposeLictTx(types.LictProposal{
+		Action: &types.LictProposal_Mint{Mint: &types.LictMint{Meter: args[0], Amount: amount}},
+	}))
+}
+
+func cmdTLTransfer(cmd *cobra.Command, args []string) error {
+	amount, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return err
+	}
+	return broadcastLictTx(cmd, args, types.LictTx{
+		Msg: &types.LictTx_Transfer{Transfer: &types.LictTransfer{Recipient: args[0], Amount: amount}},
+	})
+}
+
+func cmdTLBurn(cmd *cobra.Command, args []string) error {
+	amount, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	return broadcastLictTx(cmd, args, types.LictTx{
+		Msg: &types.LictTx_Burn{Burn: &types.LictBurn{Amount: amount}},
+	})
+}
+
+func cmdTLSetAdmins(cmd *cobra.Command, args []string) error {
+	threshold, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return err
+	}
+	return broadcastLictTx(cmd, args, proposeLictTx(types.LictProposal{
+		Action: &types.LictProposal_SetAdmins{
+			SetAdmins: &types.LictSetAdmins{Admins: strings.Split(args[0], ","), Threshold: uint32(threshold)}},
+	}))
+}
+
+func cmdTLSetAllowedMeters(cmd *cobra.Command, args []string) error {
+	pubKeys := make([]crypto.PubKey, len(args))
+	for i, arg := range args {
+		pubKey, err := tenderlic_kvstore.ParseMeterPubKey(arg)
+		if err != nil {
+			return fmt.Errorf("invalid meter pubkey %q: %v", arg, err)
+		}
+		pubKeys[i] = pubKey
+	}
+	return broadcastLictTx(cmd, args, proposeLictTx(types.LictProposal{
+		Action: &types.LictProposal_SetAllowedMeters{
+			SetAllowedMeters: tenderlic_kvstore.NewSetAllowedMeters(pubKeys...)},
+	}))
+}
+
+func cmdTLSetValidator(cmd *cobra.Command, args []string) error {
+	pubKey, err := base64.StdEncoding.DecodeString(args[0])
+	if err != nil {
+		return fmt.Errorf("pubkey (%s) is invalid base64: %v", args[0], err)
+	}
+	power, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return err
+	}
+	return broadcastLictTx(cmd, args, proposeLictTx(types.LictProposal{
+		Action: &types.LictProposal_SetValidator{
+			SetValidator: &types.LictSetValidator{PubKey: pubKey, Power: power}},
+	}))
+}
+
+func cmdTLSetQueryPolicy(cmd *cobra.Command, args []string) error {
+	return broadcastLictTx(cmd, args, proposeLictTx(types.LictProposal{
+		Action: &types.LictProposal_SetQueryPolicy{
+			SetQueryPolicy: &types.LictSetQueryPolicy{Path: args[0], Policy: args[1]}},
+	}))
+}
+
+func cmdTLApprove(cmd *cobra.Command, args []string) error {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	return broadcastLictTx(cmd, args, types.LictTx{
+		Msg: &types.LictTx_Approve{Approve: &types.LictApprove{ProposalId: id}},
+	})
+}
+
+func cmdTLExecute(cmd *cobra.Command, args []string) error {
+	id, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	return broadcastLictTx(cmd, args, types.LictTx{
+		Msg: &types.LictTx_Execute{Execute: &types.LictExecute{ProposalId: id}},
+	})
+}
+
+func cmdTLMeasure(cmd *cobra.Command, args []string) error {
+	timestamp, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+	value, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return err
+	}
+	return broadcastLictTx(cmd, args, types.LictTx{
+		Msg: &types.LictTx_PowerMeasure{PowerMeasure: &types.LictPowerMeasure{Timestamp: timestamp, Value: value}},
+	})
+}
+
+// cmdTLQuery signs a query with the key of flagKeyFile and sends it to the node
+// at flagNode.
+func cmdTLQuery(cmd *cobra.Command, args []string) error {
+	key, err := p2p.LoadNodeKey(flagKeyFile)
+	if err != nil {
+		return err
+	}
+	node, err := rpcclient.NewHTTP(flagNode, "/websocket")
+	if err != nil {
+		return err
+	}
+	info, err := node.ABCIInfo()
+	if err != nil {
+		return err
+	}
+	data, err := tenderlic_kvstore.MakeSignedQuery(key.PrivKey, args[0], []byte(args[1]), info.Response.LastBlockHeight)
+	if err != nil {
+		return err
+	}
+	res, err := node.ABCIQuery(args[0], data)
+	if err != nil {
+		return err
+	}
+	printResponse(cmd, args, response{
+		Code: res.Response.Code,
+		Info: res.Response.Info,
+		Log:  res.Response.Log,
+		Query: &queryResponse{
+			Key:    res.Response.Key,
+			Value:  res.Response.Value,
+			Height: res.Response.Height,
+			Proof:  res.Response.Proof,
+		},
+	})
+	return nil
+}
+
+// proposeLictTx wraps an admin action in a governance proposal.
+func proposeLictTx(proposal types.LictProposal) types.LictTx {
+	return types.LictTx{Msg: &types.LictTx_Propose{Propose: &types.LictPropose{Proposal: &proposal}}}
+}
+
+// broadcastLictTx signs lictTx with the key of flagKeyFile for the chain of the
+// node at flagNode and broadcasts it to the node.
+func broadcastLictTx(cmd *cobra.Command, args []string, lictTx types.LictTx) error {
+	key, err := p2p.LoadNodeKey(flagKeyFile)
+	if err != nil {
+		return err
+	}
+	node, err := rpcclient.NewHTTP(flagNode, "/websocket")
+	if err != nil {
+		return err
+	}
+
+	nonce := uint64(flagNonce)
+	if flagNonce < 0 {
+		address := key.PubKey().Address().String()
+		res, err := node.ABCIQuery("/nonce", []byte(address))
+		if err != nil {
+			return err
+		}
+		nonce, err = strconv.ParseUint(string(res.Response.Value), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid nonce of %s: %v", address, err)
+		}
+	}
+
+	// transactions are signed for the chain of the node only
+	status, err := node.Status()
+	if err != nil {
+		return err
+	}
+
+	tx, err := tenderlic_kvstore.MakeLictTx(key.PrivKey, status.NodeInfo.Network, nonce, lictTx)
+	if err != nil {
+		return err
+	}
+	res, err := node.BroadcastTxSync(tx)
+	if err != nil {
+		return err
+	}
+	printResponse(cmd, args, response{
+		Data: res.Data,
+		Code: res.Code,
+		Log:  res.Log,
+	})
+	fmt.Printf("-> hash: %X\n", res.Hash)
+	return nil
+}
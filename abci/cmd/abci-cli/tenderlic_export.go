@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/abci/example/tenderlic_kvstore"
+)
+
+// tenderlic_kvstore export
+var (
+	flagExportFormat string
+	flagExportData   string
+	flagExportOutput string
+	flagExportMeters []string
+	flagExportFrom   int64
+	flagExportTo     int64
+	flagExportHeight int64
+)
+
+var tlExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "export readings and balances from the database of a stopped node",
+	Long: `export readings and balances from the database of a stopped node
+
+The database is the one of the --persist directory. The state is exported at
+the given height, the last committed one by default, as CSV or JSON.
+
+Example:
+
+	abci-cli tenderlic_kvstore export --persist ./data --data readings --format csv \
+		--meter <address> --from 1577836800 --to 1580515200 --height 1000
+`,
+	Args: cobra.ExactArgs(0),
+	RunE: cmdTLExport,
+}
+
+func addTLKVStoreExportCommand() {
+	tlExportCmd.Flags().StringVarP(&flagExportFormat, "format", "", "csv", "output format: csv or json")
+	tlExportCmd.Flags().StringVarP(&flagExportData, "data", "", "readings",
+		"exported data: readings or balances, or all with the json format")
+	tlExportCmd.Flags().StringVarP(&flagExportOutput, "output", "o", "", "output file, stdout if empty")
+	tlExportCmd.Flags().StringArrayVarP(&flagExportMeters, "meter", "", []string{},
+		"meter or account to export, all if not set (use --meter multiple times for multiple meters)")
+	tlExportCmd.Flags().Int64VarP(&flagExportFrom, "from", "", 0, "start of the time window of the readings (unix time)")
+	tlExportCmd.Flags().Int64VarP(&flagExportTo, "to", "", 0,
+		"end of the time window of the readings (unix time, excluded), unbounded if 0")
+	tlExportCmd.Flags().Int64VarP(&flagExportHeight, "height", "", 0, "height of the state, the last committed if 0")
+	tlKVstoreCmd.AddCommand(tlExportCmd)
+}
+
+func cmdTLExport(cmd *cobra.Command, args []string) error {
+	if flagPersist == "" {
+		return errors.New("the database directory of the node must be set with --persist")
+	}
+	switch {
+	case flagExportFormat != "csv" && flagExportFormat != "json":
+		return fmt.Errorf("unknown format %q", flagExportFormat)
+	case flagExportData != "readings" && flagExportData != "balances" && flagExportData != "all":
+		return fmt.Errorf("unknown data %q", flagExportData)
+	case flagExportData == "all" && flagExportFormat == "csv":
+		return errors.New("readings and balances can be exported together only with the json format")
+	}
+
+	app := tenderlic_kvstore.NewPersistentKVStoreApplication(flagPersist)
+	defer app.Close()
+	exp, err := app.Export(tenderlic_kvstore.ExportRequest{
+		Height: flagExportHeight,
+		Meters: flagExportMeters,
+		From:   flagExportFrom,
+		To:     flagExportTo,
+	})
+	if err != nil {
+		return err
+	}
+
+	out := io.Writer(os.Stdout)
+	if flagExportOutput != "" {
+		f, err := os.Create(flagExportOutput)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	switch flagExportData {
+	case "readings":
+		exp.Balances = nil
+	case "balances":
+		exp.Readings = nil
+	}
+	if flagExportFormat == "json" {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(exp)
+	}
+	return writeExportCSV(out, exp)
+}
+
+// writeExportCSV writes the readings or the balances of exp as CSV.
+func writeExportCSV(out io.Writer, exp tenderlic_kvstore.Export) error {
+	w := csv.NewWriter(out)
+	if exp.Readings != nil {
+		w.Write([]string{"meter", "timestamp", "value", "height"})
+		for _, r := range exp.Readings {
+			w.Write([]string{
+				r.Meter,
+				strconv.FormatInt(r.Timestamp, 10),
+				strconv.FormatInt(r.Value, 10),
+				strconv.FormatInt(r.Height, 10),
+			})
+		}
+	} else {
+		w.Write([]string{"account", "balance", "height"})
+		for _, b := range exp.Balances {
+			w.Write([]string{b.Account, b.Balance.String(), strconv.FormatInt(b.Height, 10)})
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -9,4 +9,9 @@ const (
 	CodeTypeBadRequest    uint32 = 5
 	CodeNotPositiveAmount uint32 = 7
 	CodeExceedingAmount   uint32 = 8
+	CodeStaleNonce        uint32 = 9
+	CodeFutureNonce       uint32 = 10
+	CodeAmountOverflow    uint32 = 11
+	CodeInvalidBalance    uint32 = 12
+	CodeExceedingPower    uint32 = 13
 )
@@ -0,0 +1,115 @@
+package tenderlic_kvstore
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/tendermint/tendermint/abci/example/code"
+)
+
+var (
+	// ErrAmountOverflow is returned when a balance would exceed the maximum amount.
+	ErrAmountOverflow = errors.New("amount overflow")
+	// ErrInsufficientFunds is returned when a balance would become negative.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+)
+
+// Amount is a quantity of LICT tokens. Balances are stored as the decimal
+// string of an amount.
+type Amount uint64
+
+// ParseAmount parses the decimal string of an amount.
+func ParseAmount(s string) (Amount, error) {
+	amount, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %v", s, err)
+	}
+	return Amount(amount), nil
+}
+
+func (a Amount) String() string {
+	return strconv.FormatUint(uint64(a), 10)
+}
+
+// Add returns a+b, or ErrAmountOverflow.
+func (a Amount) Add(b Amount) (Amount, error) {
+	if a > math.MaxUint64-b {
+		return 0, ErrAmountOverflow
+	}
+	return a + b, nil
+}
+
+// Sub returns a-b, or ErrInsufficientFunds if b is greater than a.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	if b > a {
+		return 0, ErrInsufficientFunds
+	}
+	return a - b, nil
+}
+
+// amountCode returns the response code of an error of the token accounting.
+func amountCode(err error) uint32 {
+	switch err {
+	case ErrAmountOverflow:
+		return code.CodeAmountOverflow
+	case ErrInsufficientFunds:
+		return code.CodeExceedingAmount
+	default:
+		return code.CodeInvalidBalance
+	}
+}
+
+func balanceKey(account string) []byte {
+	return []byte(fmt.Sprintf("lict-balance_%s", account))
+}
+
+// ReadBalanceInDB returns the balance of account in the working state of the
+// block, 0 if the account has no balance yet.
+func (app *Application) ReadBalanceInDB(account string) (Amount, error) {
+	balanceRaw, err := app.state.db.Get(prefixKey(balanceKey(account)))
+	if err != nil {
+		panic(err)
+	}
+	if balanceRaw == nil {
+		return 0, nil
+	}
+	return ParseAmount(string(balanceRaw))
+}
+
+// mint returns the balance of meter after minting amount.
+func (app *Application) mint(meter string, amount Amount) (Amount, error) {
+	balance, err := app.ReadBalanceInDB(meter)
+	if err != nil {
+		return 0, err
+	}
+	return balance.Add(amount)
+}
+
+// burn returns the balance of account after burning amount.
+func (app *Application) burn(account string, amount Amount) (Amount, error) {
+	balance, err := app.ReadBalanceInDB(account)
+	if err != nil {
+		return 0, err
+	}
+	return balance.Sub(amount)
+}
+
+// transfer returns the balances of sender and recipient after moving amount
+// between them.
+func (app *Application) transfer(sender, recipient string, amount Amount) (senderBalance, recipientBalance Amount, err error) {
+	senderBalance, err = app.burn(sender, amount)
+	if err != nil {
+		return 0, 0, err
+	}
+	if sender == recipient {
+		recipientBalance, err = senderBalance.Add(amount)
+		return recipientBalance, recipientBalance, err
+	}
+	recipientBalance, err = app.mint(recipient, amount)
+	if err != nil {
+		return 0, 0, err
+	}
+	return senderBalance, recipientBalance, nil
+}
@@ -0,0 +1,33 @@
+package tenderlic_kvstore
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmount(t *testing.T) {
+	a, err := ParseAmount("18446744073709551615")
+	require.NoError(t, err)
+	assert.EqualValues(t, uint64(math.MaxUint64), a)
+	assert.Equal(t, "18446744073709551615", a.String())
+
+	for _, s := range []string{"", "-1", "1.5", "abc", "18446744073709551616"} {
+		_, err := ParseAmount(s)
+		assert.Error(t, err, s)
+	}
+
+	sum, err := Amount(1).Add(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, sum)
+	_, err = a.Add(1)
+	assert.Equal(t, ErrAmountOverflow, err)
+
+	diff, err := Amount(3).Sub(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, diff)
+	_, err = Amount(3).Sub(4)
+	assert.Equal(t, ErrInsufficientFunds, err)
+}
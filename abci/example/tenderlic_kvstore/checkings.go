@@ -1,11 +1,9 @@
 package tenderlic_kvstore
 
 import (
-	"bytes"
 	"fmt"
 	"github.com/tendermint/tendermint/abci/example/code"
 	"github.com/tendermint/tendermint/abci/types"
-	"strconv"
 	"strings"
 )
 
@@ -20,105 +18,43 @@ func (app *Application) checkMeterAllowance(allowedMetersRawData []byte, meterId
 	}
 }
 
-func (app *Application) CheckAdmin() bool {
-	if flag, _ := app.state.db.Has(prefixKey([]byte("admin"))); flag == true {
-		hashAdminOnChain, _ := app.state.db.Get(prefixKey([]byte("admin")))
-		return app.checkMacAddress(string(hashAdminOnChain))
-	} else {
-		return true
-	}
-}
-
-func (app *Application) checkMacAddress(macToCheck string) bool {
-	macs, _ := app.GetMacAddr()
-	if macToCheck == app.CalcSHA512Hash(macs[0]) {
-		return true
-	} else {
-		return false
-	}
-}
-
-func (app *Application) CheckTxAdmin() types.ResponseCheckTx {
-	logger.Info(fmt.Sprintf("Admin setting transaction"))
-	if !app.CheckAdmin() {
-		logger.Warning("ACCESS DENIED! You are not the community admin!")
-		return types.ResponseCheckTx{Code: code.CodeTypeUnauthorized, GasWanted: 1}
-	} else {
-		logger.Info(fmt.Sprintf("Set new admin"))
-		return types.ResponseCheckTx{Code: code.CodeTypeOK, GasWanted: 1}
-	}
-}
-
-func (app *Application) CheckTxAllowed() types.ResponseCheckTx {
-	logger.Info(fmt.Sprintf("Allowance meters setting transaction"))
-
-	if !app.CheckAdmin() {
-		logger.Warning("ACCESS DENIED! You are not the community admin, you can not change the allowed meters list")
-		return types.ResponseCheckTx{Code: code.CodeTypeUnauthorized, GasWanted: 1}
-	} else {
-		logger.Info(fmt.Sprintf("Set new meters list"))
-		return types.ResponseCheckTx{Code: code.CodeTypeOK, GasWanted: 1}
+func (app *Application) CheckTxPowerMeasure(msg *types.LictPowerMeasure) types.ResponseCheckTx {
+	if msg.Timestamp < 0 {
+		logger.Warning(fmt.Sprintf("The timestamp must not be negative"))
+		return types.ResponseCheckTx{Code: code.CodeTypeBadRequest, GasWanted: 1}
 	}
-}
-
-func (app *Application) CheckTxPowerMeasure(keyInfo []string) types.ResponseCheckTx {
 	return types.ResponseCheckTx{Code: code.CodeTypeOK, GasWanted: 1}
 }
 
-func (app *Application) CheckTxMint(keyInfo []string, valueInfo string) types.ResponseCheckTx {
-	logger.Info(fmt.Sprintf("Tokens mint transaction"))
-	if !app.CheckAdmin() {
-		logger.Warning("ACCESS DENIED! You are not the community admin, you can not mint tokens")
-		return types.ResponseCheckTx{Code: code.CodeTypeUnauthorized, GasWanted: 1}
-	} else {
-		// Check the meter allowance
-		allowedMeters := app.GetAllowedMeters()
-		if app.checkMeterAllowance(allowedMeters, keyInfo[1]) == false {
-			return types.ResponseCheckTx{Code: code.CodeTypeUnauthorized, GasWanted: 1}
-		} else {
-			amount, _ := strconv.Atoi(valueInfo)
-			if amount <= 0 {
-				logger.Warning(fmt.Sprintf("The amount must be positive"))
-				return types.ResponseCheckTx{Code: code.CodeNotPositiveAmount, GasWanted: 1}
-			} else {
-				logger.Info(fmt.Sprintf("Successfull minting"))
-				return types.ResponseCheckTx{Code: code.CodeTypeOK, GasWanted: 1}
-			}
-		}
-	}
-}
-
-func (app *Application) CheckTxSelfBurn(valueInfo string) types.ResponseCheckTx {
+func (app *Application) CheckTxSelfBurn(sender string, msg *types.LictBurn) types.ResponseCheckTx {
 	logger.Info(fmt.Sprintf("Tokens burn transaction"))
 
-	amount, _ := strconv.Atoi(valueInfo)
-	if amount <= 0 {
+	if msg.Amount == 0 {
 		logger.Warning(fmt.Sprintf("The amount must be positive"))
 		return types.ResponseCheckTx{Code: code.CodeNotPositiveAmount, GasWanted: 1}
+	} else if _, err := app.burn(sender, Amount(msg.Amount)); err != nil {
+		logger.Warning(fmt.Sprintf("The amount to burn exceeds the sender balance"))
+		return types.ResponseCheckTx{Code: amountCode(err), Log: err.Error(), GasWanted: 1}
 	} else {
 		logger.Info(fmt.Sprintf("Successfull burning"))
 		return types.ResponseCheckTx{Code: code.CodeTypeOK, GasWanted: 1}
 	}
 }
-func (app *Application) CheckTxTransfer(keyInfo []string, valueInfo string) types.ResponseCheckTx {
+
+func (app *Application) CheckTxTransfer(sender string, msg *types.LictTransfer) types.ResponseCheckTx {
 	logger.Info(fmt.Sprintf("Tokens transfer transaction"))
-	receiver := keyInfo[1]
 	allowedMeters := app.GetAllowedMeters()
 	// Check if the receiver is an allowed meter
-	if app.checkMeterAllowance(allowedMeters, receiver) == false {
+	if app.checkMeterAllowance(allowedMeters, msg.Recipient) == false {
 		return types.ResponseCheckTx{Code: code.CodeTypeUnauthorized, GasWanted: 1}
 	} else {
-		amount, _ := strconv.Atoi(valueInfo)
-		if amount <= 0 {
+		if msg.Amount == 0 {
 			logger.Warning(fmt.Sprintf("The amount must be positive"))
 			return types.ResponseCheckTx{Code: code.CodeNotPositiveAmount, GasWanted: 1}
 		} else {
-			mac, _ := app.GetMacAddr()
-			keySenderBalance := []byte(fmt.Sprintf("lict-balance_%s", app.CalcSHA512Hash(mac[0])))
-			senderBalance := app.ReadBalanceInDB(keySenderBalance)
-			if amount > senderBalance {
-				logger.Warning(fmt.Sprintf("The amount to transfer exceeds the sender balance"))
-				return types.ResponseCheckTx{Code: code.CodeExceedingAmount, GasWanted: 1}
+			if _, _, err := app.transfer(sender, msg.Recipient, Amount(msg.Amount)); err != nil {
+				logger.Warning(fmt.Sprintf("Transfer not possible: %v", err))
+				return types.ResponseCheckTx{Code: amountCode(err), Log: err.Error(), GasWanted: 1}
 			} else {
 				logger.Info(fmt.Sprintf("Successfull transfer"))
 				return types.ResponseCheckTx{Code: code.CodeTypeOK, GasWanted: 1}
@@ -127,44 +63,73 @@ func (app *Application) CheckTxTransfer(keyInfo []string, valueInfo string) type
 	}
 }
 
+// Priorities of the transactions in a prioritized mempool: the governance
+// transactions first, then the meter readings and the token movements.
+const (
+	PriorityTokens int64 = iota + 1
+	PriorityMeasure
+	PriorityGovernance
+)
+
+// txPriority returns the mempool priority of a transaction.
+func txPriority(lictTx *types.LictTx) int64 {
+	switch lictTx.Msg.(type) {
+	case *types.LictTx_Propose, *types.LictTx_Approve, *types.LictTx_Execute:
+		return PriorityGovernance
+	case *types.LictTx_PowerMeasure:
+		return PriorityMeasure
+	default:
+		return PriorityTokens
+	}
+}
+
 func (app *Application) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
 	// Set the logger
 	app.SetLogger()
 
-	// Initialize the response
-	resp := types.ResponseCheckTx{Code: code.CodeTypeOK, GasWanted: 1}
-
-	data := bytes.Split(req.Tx, []byte("="))
-	if len(data) == 2 {
-		var keyInfo = strings.Split(string(data[0]), "_")
-		var valueInfo = string(data[1])
-
-		logger.Info(fmt.Sprintf("Checking transaction %s", req.String()))
-		logger.Info(fmt.Sprintf("Checking transaction "))
-
-		if keyInfo[0] == "admin" {
-			resp = app.CheckTxAdmin()
-		} else if keyInfo[0] == "allowed" {
-			resp = app.CheckTxAllowed()
-		} else if keyInfo[0] == "lict-mint" {
-			resp = app.CheckTxMint(keyInfo, valueInfo)
-		} else if keyInfo[0] == "lict-selfburn" {
-			resp = app.CheckTxSelfBurn(valueInfo)
-		} else if keyInfo[0] == "lict-transfer" {
-			resp = app.CheckTxTransfer(keyInfo, valueInfo)
-		} else if keyInfo[0] == "meter" && len(keyInfo) == 2 {
-			resp = app.CheckTxPowerMeasure(keyInfo)
-		} else {
-			logger.Error(fmt.Sprintf("Bad request"))
-			resp = types.ResponseCheckTx{Code: code.CodeTypeBadRequest, GasWanted: 1}
-		}
+	// Verify the envelope, the sender is the signer of the transaction
+	tx, err := DecodeTx(req.Tx, app.state.ChainID)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Bad signed transaction: %v", err))
+		return types.ResponseCheckTx{Code: code.CodeTypeEncodingError, Log: err.Error(), GasWanted: 1}
+	}
+	sender := tx.Sender()
 
-		if resp.Code == code.CodeTypeOK {
-			logger.Info(fmt.Sprintf("Transaction OK"))
-		}
+	resp := app.CheckTxNonce(tx)
+	if resp.Code != code.CodeTypeOK {
 		return resp
-	} else {
-		logger.Error(fmt.Sprintf("Bad request"))
-		return types.ResponseCheckTx{Code: code.CodeTypeBadRequest, GasWanted: 1}
 	}
+
+	lictTx, err := DecodeLictTx(tx.Payload)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Bad request: %v", err))
+		return types.ResponseCheckTx{Code: code.CodeTypeEncodingError, Log: err.Error(), GasWanted: 1}
+	}
+
+	logger.Info(fmt.Sprintf("Checking transaction %s", lictTx.String()))
+
+	switch msg := lictTx.Msg.(type) {
+	case *types.LictTx_Propose:
+		resp = app.CheckTxPropose(sender, msg.Propose)
+	case *types.LictTx_Approve:
+		resp = app.CheckTxApprove(sender, msg.Approve)
+	case *types.LictTx_Execute:
+		resp = app.CheckTxExecute(sender, msg.Execute)
+	case *types.LictTx_Burn:
+		resp = app.CheckTxSelfBurn(sender, msg.Burn)
+	case *types.LictTx_Transfer:
+		resp = app.CheckTxTransfer(sender, msg.Transfer)
+	case *types.LictTx_PowerMeasure:
+		resp = app.CheckTxPowerMeasure(msg.PowerMeasure)
+	}
+
+	if resp.Code == code.CodeTypeOK {
+		logger.Info(fmt.Sprintf("Transaction OK"))
+		app.checkNonces[sender] = tx.Nonce + 1
+	}
+	// Let a prioritized mempool keep the transactions of the sender in the
+	// order of their nonces
+	resp.Sender = sender
+	resp.Priority = txPriority(&lictTx)
+	return resp
 }
@@ -1,90 +1,123 @@
 package tenderlic_kvstore
 
 import (
-	"bytes"
 	"fmt"
 	"github.com/tendermint/tendermint/abci/example/code"
 	"github.com/tendermint/tendermint/abci/types"
-	"strconv"
-	"strings"
 )
 
 func (app *Application) SetKVOnDB(key []byte, value []byte) types.ResponseDeliverTx {
 	app.state.db.Set(prefixKey(key), value)
+	app.recordHistory(key, value)
 	app.state.Size++
 	return types.ResponseDeliverTx{Code: code.CodeTypeOK}
 }
 
-func (app *Application) DeliverTxMint(keyInfo []string, amountRaw []byte) types.ResponseDeliverTx {
-	var keyBalance = []byte(fmt.Sprintf("lict-balance_%s", keyInfo[1]))
-	storedBalance := app.ReadBalanceInDB(keyBalance)
-	amount, _ := strconv.Atoi(string(amountRaw))
-	return app.SetKVOnDB(keyBalance, []byte(fmt.Sprintf("%d", storedBalance+amount)))
+// DeliverTxAllowed replaces the allowed meters and registers their public keys.
+func (app *Application) DeliverTxAllowed(msg *types.LictSetAllowedMeters) types.ResponseDeliverTx {
+	pubKeys, err := decodeMeterKeys(msg)
+	if err != nil {
+		return types.ResponseDeliverTx{Code: code.CodeTypeBadRequest, Log: err.Error()}
+	}
+	return app.saveMeters(pubKeys)
 }
 
-func (app *Application) DeliverTxSelfBurn(amountRaw []byte) types.ResponseDeliverTx {
-	var mac, _ = app.GetMacAddr()
-	var keyBalance = []byte(fmt.Sprintf("lict-balance_%s", app.CalcSHA512Hash(mac[0])))
-	storedBalance := app.ReadBalanceInDB(keyBalance)
-	amount, _ := strconv.Atoi(string(amountRaw))
-	return app.SetKVOnDB(keyBalance, []byte(fmt.Sprintf("%d", storedBalance-amount)))
+func (app *Application) DeliverTxMint(msg *types.LictMint) types.ResponseDeliverTx {
+	if !app.checkMeterAllowance(app.GetAllowedMeters(), msg.Meter) {
+		return types.ResponseDeliverTx{Code: code.CodeTypeUnauthorized}
+	}
+	amount := Amount(msg.Amount)
+	if amount == 0 {
+		return types.ResponseDeliverTx{Code: code.CodeNotPositiveAmount}
+	}
+	balance, err := app.mint(msg.Meter, amount)
+	if err != nil {
+		return types.ResponseDeliverTx{Code: amountCode(err), Log: err.Error()}
+	}
+	res := app.SetKVOnDB(balanceKey(msg.Meter), []byte(balance.String()))
+	res.Events = []types.Event{mintEvent(msg.Meter, amount)}
+	return res
 }
-func (app *Application) DeliverTxPowerMeasure(dtStr string, valueStr []byte) types.ResponseDeliverTx {
-	var mac, _ = app.GetMacAddr()
-	return app.SetKVOnDB([]byte(fmt.Sprintf("meter_%s_%s", app.CalcSHA512Hash(mac[0]), dtStr)), valueStr)
+
+func (app *Application) DeliverTxSelfBurn(sender string, msg *types.LictBurn) types.ResponseDeliverTx {
+	amount := Amount(msg.Amount)
+	if amount == 0 {
+		return types.ResponseDeliverTx{Code: code.CodeNotPositiveAmount}
+	}
+	balance, err := app.burn(sender, amount)
+	if err != nil {
+		return types.ResponseDeliverTx{Code: amountCode(err), Log: err.Error()}
+	}
+	res := app.SetKVOnDB(balanceKey(sender), []byte(balance.String()))
+	res.Events = []types.Event{burnEvent(sender, amount)}
+	return res
+}
+
+func (app *Application) DeliverTxPowerMeasure(sender string, msg *types.LictPowerMeasure) types.ResponseDeliverTx {
+	if msg.Timestamp < 0 {
+		return types.ResponseDeliverTx{Code: code.CodeTypeBadRequest}
+	}
+	res := app.SetKVOnDB(measureKey(sender, msg.Timestamp), []byte(fmt.Sprintf("%d", msg.Value)))
+	res.Events = []types.Event{measureEvent(sender, msg.Timestamp, msg.Value)}
+	return res
 }
 
-func (app *Application) DeliverTxTransfer(keyInfo []string, amount []byte) types.ResponseDeliverTx {
-	var mac, _ = app.GetMacAddr()
-	var keySenderBalance = []byte(fmt.Sprintf("lict-balance_%s", app.CalcSHA512Hash(mac[0])))
-	var keyReceiverBalance = []byte(fmt.Sprintf("lict-balance_%s", keyInfo[1]))
-	amountToTransfer, _ := strconv.Atoi(string(amount))
+func (app *Application) DeliverTxTransfer(sender string, msg *types.LictTransfer) types.ResponseDeliverTx {
+	amount := Amount(msg.Amount)
+	if amount == 0 {
+		return types.ResponseDeliverTx{Code: code.CodeNotPositiveAmount}
+	}
 
-	var senderBalance = app.ReadBalanceInDB(keySenderBalance)
-	var receiverBalance = app.ReadBalanceInDB(keyReceiverBalance)
+	// The balances are checked again against the working state of the block,
+	// CheckTx only knows the balances of the last commit
+	senderBalance, receiverBalance, err := app.transfer(sender, msg.Recipient, amount)
+	if err != nil {
+		return types.ResponseDeliverTx{Code: amountCode(err), Log: err.Error()}
+	}
 
-	resSender := app.SetKVOnDB(keySenderBalance, []byte(fmt.Sprintf("%d", senderBalance-amountToTransfer)))
-	resReceiver := app.SetKVOnDB(keyReceiverBalance, []byte(fmt.Sprintf("%d", receiverBalance+amountToTransfer)))
+	resSender := app.SetKVOnDB(balanceKey(sender), []byte(senderBalance.String()))
+	resReceiver := app.SetKVOnDB(balanceKey(msg.Recipient), []byte(receiverBalance.String()))
 
 	if resSender.Code == code.CodeTypeOK && resReceiver.Code == code.CodeTypeOK {
-		return types.ResponseDeliverTx{Code: code.CodeTypeOK}
+		return types.ResponseDeliverTx{
+			Code:   code.CodeTypeOK,
+			Events: []types.Event{transferEvent(sender, msg.Recipient, amount)},
+		}
 	} else {
 		return types.ResponseDeliverTx{Code: code.CodeTypeBadRequest}
 	}
-	return types.ResponseDeliverTx{Code: code.CodeTypeOK}
 }
 
-func (app *Application) ReadBalanceInDB(key []byte) int {
-	var balance = 0
-	if flag, _ := app.state.db.Has(prefixKey(key)); flag == true {
-		var balanceRaw, _ = app.state.db.Get(prefixKey(key))
-		balance, _ = strconv.Atoi(string(balanceRaw))
+func (app *Application) DeliverTx(req types.RequestDeliverTx) types.ResponseDeliverTx {
+	tx, err := DecodeTx(req.Tx, app.state.ChainID)
+	if err != nil {
+		return types.ResponseDeliverTx{Code: code.CodeTypeEncodingError, Log: err.Error()}
 	}
-	return balance
-}
+	sender := tx.Sender()
 
-func (app *Application) DeliverTx(req types.RequestDeliverTx) types.ResponseDeliverTx {
-	var key, value []byte
-	var keyInfo []string
+	if res := app.DeliverTxNonce(tx); res.Code != code.CodeTypeOK {
+		return res
+	}
 
-	parts := bytes.Split(req.Tx, []byte("="))
-	if len(parts) == 2 {
-		key, value = parts[0], parts[1]
-		keyInfo = strings.Split(string(key), "_")
-		cmd := keyInfo[0]
+	lictTx, err := DecodeLictTx(tx.Payload)
+	if err != nil {
+		return types.ResponseDeliverTx{Code: code.CodeTypeEncodingError, Log: err.Error()}
+	}
 
-		if cmd == "lict-mint" {
-			return app.DeliverTxMint(keyInfo, value)
-		} else if cmd == "lict-selfburn" {
-			return app.DeliverTxSelfBurn(value)
-		} else if cmd == "lict-transfer" {
-			return app.DeliverTxTransfer(keyInfo, value)
-		} else if cmd == "meter" {
-			return app.DeliverTxPowerMeasure(keyInfo[1], value)
-		} else {
-			return app.SetKVOnDB(key, value)
-		}
-	} else {
+	switch msg := lictTx.Msg.(type) {
+	case *types.LictTx_Propose:
+		return app.DeliverTxPropose(sender, msg.Propose)
+	case *types.LictTx_Approve:
+		return app.DeliverTxApprove(sender, msg.Approve)
+	case *types.LictTx_Execute:
+		return app.DeliverTxExecute(sender, msg.Execute)
+	case *types.LictTx_Burn:
+		return app.DeliverTxSelfBurn(sender, msg.Burn)
+	case *types.LictTx_Transfer:
+		return app.DeliverTxTransfer(sender, msg.Transfer)
+	case *types.LictTx_PowerMeasure:
+		return app.DeliverTxPowerMeasure(sender, msg.PowerMeasure)
+	default:
 		return types.ResponseDeliverTx{Code: code.CodeTypeBadRequest}
 	}
 }
@@ -0,0 +1,51 @@
+package tenderlic_kvstore
+
+import (
+	"strconv"
+
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+)
+
+// Types of the events of the delivered transactions. The attributes can be
+// indexed and queried as <type>.<key>, e.g. measure.meter='<address>'.
+const (
+	EventTypeTransfer  = "transfer"
+	EventTypeMint      = "mint"
+	EventTypeBurn      = "burn"
+	EventTypeMeasure   = "measure"
+	EventTypeProposal  = "proposal"
+	EventTypeValidator = "validator"
+)
+
+func newEvent(eventType string, attrs ...string) types.Event {
+	event := types.Event{Type: eventType}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, kv.Pair{Key: []byte(attrs[i]), Value: []byte(attrs[i+1])})
+	}
+	return event
+}
+
+func transferEvent(sender, recipient string, amount Amount) types.Event {
+	return newEvent(EventTypeTransfer, "sender", sender, "recipient", recipient, "amount", amount.String())
+}
+
+func mintEvent(meter string, amount Amount) types.Event {
+	return newEvent(EventTypeMint, "meter", meter, "amount", amount.String())
+}
+
+func burnEvent(sender string, amount Amount) types.Event {
+	return newEvent(EventTypeBurn, "sender", sender, "amount", amount.String())
+}
+
+func measureEvent(meter string, timestamp, value int64) types.Event {
+	return newEvent(EventTypeMeasure,
+		"meter", meter,
+		"timestamp", strconv.FormatInt(timestamp, 10),
+		"value", strconv.FormatInt(value, 10))
+}
+
+// proposalEvent is emitted by the propose, approve and execute transactions.
+func proposalEvent(action string, id uint64, sender string) types.Event {
+	return newEvent(EventTypeProposal, "action", action, "id", strconv.FormatUint(id, 10), "sender", sender)
+}
@@ -0,0 +1,72 @@
+package tenderlic_kvstore
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/code"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+)
+
+// eventsMap flattens events as the event bus does for subscriptions.
+func eventsMap(events []types.Event) map[string][]string {
+	m := make(map[string][]string)
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			key := fmt.Sprintf("%s.%s", event.Type, attr.Key)
+			m[key] = append(m[key], string(attr.Value))
+		}
+	}
+	return m
+}
+
+func TestEvents(t *testing.T) {
+	kvstore := NewApplication()
+	admin := ed25519.GenPrivKey()
+	meter := ed25519.GenPrivKey()
+	adminID := admin.PubKey().Address().String()
+	meterID := meter.PubKey().Address().String()
+
+	deliver := func(privKey crypto.PrivKey, lictTx types.LictTx) map[string][]string {
+		res := kvstore.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, kvstore, privKey, lictTx)})
+		require.Equal(t, code.CodeTypeOK, res.Code, res)
+		return eventsMap(res.Events)
+	}
+	matches := func(q string, events map[string][]string) bool {
+		ok, err := query.MustParse(q).Matches(events)
+		require.NoError(t, err)
+		return ok
+	}
+
+	kvstore.InitChain(genesisRequest(t, 1, admin))
+	events := deliver(admin, allowedTx(admin.PubKey(), meter.PubKey()))
+	assert.Equal(t, []string{"propose"}, events["proposal.action"])
+	events = deliver(admin, executeTx(0))
+	assert.Equal(t, []string{"execute"}, events["proposal.action"])
+	deliver(admin, mintTx(meterID, 10))
+	events = deliver(admin, executeTx(1))
+	assert.True(t, matches(fmt.Sprintf("mint.meter='%s' AND mint.amount=10", meterID), events))
+
+	events = deliver(meter, transferTx(adminID, 4))
+	assert.True(t, matches(fmt.Sprintf("transfer.sender='%s' AND transfer.recipient='%s'", meterID, adminID), events))
+	assert.False(t, matches(fmt.Sprintf("transfer.sender='%s'", adminID), events))
+
+	events = deliver(meter, measureTx(1500, 42))
+	assert.True(t, matches(fmt.Sprintf("measure.meter='%s' AND measure.timestamp>1000", meterID), events))
+	assert.False(t, matches("measure.timestamp<1000", events))
+	assert.Equal(t, []string{"42"}, events["measure.value"])
+
+	events = deliver(meter, types.LictTx{Msg: &types.LictTx_Burn{Burn: &types.LictBurn{Amount: 1}}})
+	assert.True(t, matches(fmt.Sprintf("burn.sender='%s' AND burn.amount=1", meterID), events))
+
+	// failed transactions have no events
+	res := kvstore.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, kvstore, meter, transferTx(adminID, 100))})
+	assert.Equal(t, code.CodeExceedingAmount, res.Code)
+	assert.Empty(t, res.Events)
+}
@@ -0,0 +1,246 @@
+package tenderlic_kvstore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// The readings and balances written by the transactions are also stored,
+// outside of the merkle store, under history:<key>:<height>, so that the state
+// of a past height (not before the retain height) can be exported.
+var (
+	historyPrefixKey = []byte("history:")
+	// history-pruning:<height>:<key> marks the entries of key written before
+	// height, which are pruned once height is retained
+	historyPruningPrefixKey = []byte("history-pruning:")
+
+	historyKinds = [][]byte{[]byte("meter_"), []byte("lict-balance_")}
+)
+
+func historyKey(key []byte, height int64) []byte {
+	return []byte(fmt.Sprintf("%s%s:%020d", historyPrefixKey, key, height))
+}
+
+func historyPruningKey(height int64, key []byte) []byte {
+	return []byte(fmt.Sprintf("%s%020d:%s", historyPruningPrefixKey, height, key))
+}
+
+// recordHistory stores value as the value of key at the height of the block
+// being executed, if key is a reading or a balance.
+func (app *Application) recordHistory(key, value []byte) {
+	for _, kind := range historyKinds {
+		if bytes.HasPrefix(key, kind) {
+			height := app.state.Height + 1
+			app.state.db.Set(historyKey(key, height), value)
+			app.state.db.Set(historyPruningKey(height, key), []byte{})
+			return
+		}
+	}
+}
+
+// pruneHistory deletes the history entries superseded at or before
+// retainHeight: the value of a key at every height >= retainHeight can still
+// be exported.
+func (app *Application) pruneHistory(retainHeight int64) {
+	if retainHeight <= app.state.RetainHeight {
+		return
+	}
+
+	itr, err := app.state.db.Iterator(historyPruningKey(0, nil), historyPruningKey(retainHeight+1, nil))
+	if err != nil {
+		panic(err)
+	}
+	var pruning [][]byte
+	for ; itr.Valid(); itr.Next() {
+		pruning = append(pruning, itr.Key())
+	}
+	itr.Close()
+
+	offset := len(historyPruningKey(0, nil))
+	for _, pk := range pruning {
+		height, err := strconv.ParseInt(string(pk[len(historyPruningPrefixKey):offset-1]), 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		app.deleteHistory(pk[offset:], height)
+		app.state.db.Delete(pk)
+	}
+	app.state.RetainHeight = retainHeight
+}
+
+// deleteHistory deletes the history entries of key before height.
+func (app *Application) deleteHistory(key []byte, height int64) {
+	itr, err := app.state.db.Iterator(historyKey(key, 0), historyKey(key, height))
+	if err != nil {
+		panic(err)
+	}
+	var keys [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	itr.Close()
+
+	for _, k := range keys {
+		app.state.db.Delete(k)
+	}
+}
+
+// valueAt returns the value of key at height and the height it was written
+// at. Keys written before the history was recorded have height 0.
+func (app *Application) valueAt(key []byte, height int64) (value []byte, writtenAt int64, ok bool) {
+	itr, err := app.state.db.ReverseIterator(historyKey(key, 0), historyKey(key, height+1))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+	if itr.Valid() {
+		hkey := string(itr.Key())
+		writtenAt, err := strconv.ParseInt(hkey[strings.LastIndex(hkey, ":")+1:], 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		return itr.Value(), writtenAt, true
+	}
+
+	// without history, the current value is used if it was never rewritten
+	if hasHistory(app.state.db, key) {
+		return nil, 0, false
+	}
+	value, err = app.state.db.Get(prefixKey(key))
+	if err != nil {
+		panic(err)
+	}
+	return value, 0, value != nil
+}
+
+func hasHistory(db dbm.DB, key []byte) bool {
+	itr, err := db.Iterator(historyKey(key, 0), historyKey(key, 1<<62))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+	return itr.Valid()
+}
+
+// ExportRequest selects the readings and balances of an export.
+type ExportRequest struct {
+	// Height of the exported state, the last committed height if 0
+	Height int64
+	// Meters or accounts to export, all if empty
+	Meters []string
+	// Time window [From, To) of the readings, To being unbounded if 0
+	From int64
+	To   int64
+}
+
+// ExportedReading is a reading of a meter, written at Height.
+type ExportedReading struct {
+	Meter     string `json:"meter"`
+	Timestamp int64  `json:"timestamp"`
+	Value     int64  `json:"value"`
+	Height    int64  `json:"height"`
+}
+
+// ExportedBalance is the balance of an account, last changed at Height.
+type ExportedBalance struct {
+	Account string `json:"account"`
+	Balance Amount `json:"balance"`
+	Height  int64  `json:"height"`
+}
+
+// Export is the state of the readings and balances at a height.
+type Export struct {
+	Height   int64             `json:"height"`
+	Readings []ExportedReading `json:"readings"`
+	Balances []ExportedBalance `json:"balances"`
+}
+
+// Export returns the readings and balances selected by req, ordered by meter
+// and timestamp, and by account.
+func (app *Application) Export(req ExportRequest) (Export, error) {
+	height := req.Height
+	if height == 0 {
+		height = app.state.Height
+	}
+	switch {
+	case height < 1:
+		return Export{}, errors.New("nothing to export before the first block")
+	case height > app.state.Height:
+		return Export{}, fmt.Errorf("height %d is after the last committed height %d", height, app.state.Height)
+	case height < app.state.RetainHeight:
+		return Export{}, fmt.Errorf("height %d is pruned, the history is retained from height %d",
+			height, app.state.RetainHeight)
+	case req.From < 0 || req.To < 0 || (req.To != 0 && req.To <= req.From):
+		return Export{}, errors.New("invalid time window")
+	}
+
+	meters := make(map[string]bool, len(req.Meters))
+	for _, m := range req.Meters {
+		meters[m] = true
+	}
+	selected := func(meter string) bool {
+		return len(meters) == 0 || meters[meter]
+	}
+
+	exp := Export{Height: height, Readings: []ExportedReading{}, Balances: []ExportedBalance{}}
+	app.iteratePrefix("meter_", func(key string) {
+		// meter_<meter>_<timestamp>
+		i := strings.LastIndex(key, "_")
+		meter := key[len("meter_"):i]
+		timestamp, err := strconv.ParseInt(key[i+1:], 10, 64)
+		if err != nil || !selected(meter) || timestamp < req.From || (req.To != 0 && timestamp >= req.To) {
+			return
+		}
+		bz, writtenAt, ok := app.valueAt([]byte(key), height)
+		if !ok {
+			return
+		}
+		value, err := strconv.ParseInt(string(bz), 10, 64)
+		if err != nil {
+			return
+		}
+		exp.Readings = append(exp.Readings, ExportedReading{meter, timestamp, value, writtenAt})
+	})
+	app.iteratePrefix("lict-balance_", func(key string) {
+		account := key[len("lict-balance_"):]
+		if !selected(account) {
+			return
+		}
+		bz, writtenAt, ok := app.valueAt([]byte(key), height)
+		if !ok {
+			return
+		}
+		balance, err := ParseAmount(string(bz))
+		if err != nil {
+			return
+		}
+		exp.Balances = append(exp.Balances, ExportedBalance{account, balance, writtenAt})
+	})
+
+	// the keys are ordered by the zero padded timestamps of the meters
+	sort.SliceStable(exp.Readings, func(i, j int) bool {
+		return exp.Readings[i].Meter < exp.Readings[j].Meter
+	})
+	return exp, nil
+}
+
+// iteratePrefix calls fn with the keys of the store starting with prefix,
+// without the store prefix.
+func (app *Application) iteratePrefix(prefix string, fn func(key string)) {
+	start := prefixKey([]byte(prefix))
+	end := append(prefixKey([]byte(prefix[:len(prefix)-1])), prefix[len(prefix)-1]+1)
+	itr, err := app.state.db.Iterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		fn(string(itr.Key()[len(kvPairPrefixKey):]))
+	}
+}
@@ -0,0 +1,101 @@
+package tenderlic_kvstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/code"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestExport(t *testing.T) {
+	kvstore := NewApplication()
+	meter1, meter2 := ed25519.GenPrivKey(), ed25519.GenPrivKey()
+	id1 := meter1.PubKey().Address().String()
+	id2 := meter2.PubKey().Address().String()
+
+	kvstore.InitChain(appStateRequest(t, GenesisState{
+		Meters:   []GenesisMeter{{PubKey: meter1.PubKey()}, {PubKey: meter2.PubKey()}},
+		Balances: []GenesisBalance{{Account: id1, Amount: 10}},
+	}))
+	_, err := kvstore.Export(ExportRequest{})
+	require.Error(t, err, "nothing is committed")
+
+	block := func(txs ...[]byte) {
+		kvstore.BeginBlock(types.RequestBeginBlock{})
+		for _, tx := range txs {
+			res := kvstore.DeliverTx(types.RequestDeliverTx{Tx: tx})
+			require.Equal(t, code.CodeTypeOK, res.Code, res)
+		}
+		kvstore.EndBlock(types.RequestEndBlock{})
+		kvstore.Commit()
+	}
+	tx := func(privKey crypto.PrivKey, nonce uint64, lictTx types.LictTx) []byte {
+		return signTx(t, privKey, nonce, lictTx)
+	}
+
+	// height 1
+	block(tx(meter1, 0, measureTx(100, 1)), tx(meter2, 0, measureTx(100, 2)))
+	// height 2
+	block(tx(meter1, 1, measureTx(200, 3)), tx(meter1, 2, transferTx(id2, 4)))
+	// height 3: a reading is rewritten
+	block(tx(meter1, 3, measureTx(100, 5)), tx(meter2, 1, transferTx(id1, 1)))
+
+	exp, err := kvstore.Export(ExportRequest{Height: 1})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, exp.Height)
+	assert.ElementsMatch(t, []ExportedReading{
+		{Meter: id1, Timestamp: 100, Value: 1, Height: 1},
+		{Meter: id2, Timestamp: 100, Value: 2, Height: 1},
+	}, exp.Readings)
+	assert.Equal(t, []ExportedBalance{{Account: id1, Balance: 10, Height: 1}}, exp.Balances)
+
+	exp, err = kvstore.Export(ExportRequest{Height: 2, Meters: []string{id1}})
+	require.NoError(t, err)
+	assert.Equal(t, []ExportedReading{
+		{Meter: id1, Timestamp: 100, Value: 1, Height: 1},
+		{Meter: id1, Timestamp: 200, Value: 3, Height: 2},
+	}, exp.Readings)
+	assert.Equal(t, []ExportedBalance{{Account: id1, Balance: 6, Height: 2}}, exp.Balances)
+
+	// the last committed height
+	exp, err = kvstore.Export(ExportRequest{From: 100, To: 200})
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, exp.Height)
+	assert.ElementsMatch(t, []ExportedReading{
+		{Meter: id1, Timestamp: 100, Value: 5, Height: 3},
+		{Meter: id2, Timestamp: 100, Value: 2, Height: 1},
+	}, exp.Readings)
+	assert.ElementsMatch(t, []ExportedBalance{
+		{Account: id1, Balance: 7, Height: 3},
+		{Account: id2, Balance: 3, Height: 3},
+	}, exp.Balances)
+
+	_, err = kvstore.Export(ExportRequest{Height: 4})
+	assert.Error(t, err)
+	_, err = kvstore.Export(ExportRequest{From: 200, To: 100})
+	assert.Error(t, err)
+
+	// only the readings and balances have a history
+	assert.False(t, hasHistory(kvstore.state.db, nonceKey(id1)))
+
+	// the history is pruned with the blocks
+	kvstore.RetainBlocks = 2
+	// height 4, retaining height 3
+	block()
+	_, err = kvstore.Export(ExportRequest{Height: 2})
+	assert.Error(t, err)
+	exp, err = kvstore.Export(ExportRequest{Height: 3, From: 100, To: 200})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []ExportedReading{
+		{Meter: id1, Timestamp: 100, Value: 5, Height: 3},
+		{Meter: id2, Timestamp: 100, Value: 2, Height: 1},
+	}, exp.Readings)
+	has, err := kvstore.state.db.Has(historyKey(measureKey(id1, 100), 1))
+	require.NoError(t, err)
+	assert.False(t, has, "the superseded reading is pruned")
+}
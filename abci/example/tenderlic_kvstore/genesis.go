@@ -0,0 +1,234 @@
+package tenderlic_kvstore
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+// GenesisState is the app_state of the genesis document, amino JSON encoded
+// like the rest of the document.
+type GenesisState struct {
+	Admins    []string `json:"admins"`
+	Threshold uint32   `json:"threshold"`
+	// Maximum voting power of a validator, DefaultMaxValidatorPower if 0
+	MaxValidatorPower int64 `json:"max_validator_power"`
+	// Allowed meters, registered with their public keys
+	Meters []GenesisMeter `json:"meters"`
+	// Starting LICT balances of the allowed meters
+	Balances []GenesisBalance `json:"balances"`
+}
+
+// GenesisMeter is an allowed meter of the genesis app_state.
+type GenesisMeter struct {
+	PubKey crypto.PubKey `json:"pub_key"`
+}
+
+// Address returns the account of the meter.
+func (m GenesisMeter) Address() string {
+	return m.PubKey.Address().String()
+}
+
+// ParseMeterPubKey parses the public key of a meter: the amino JSON of a
+// crypto.PubKey (e.g. {"type":"tendermint/PubKeyEd25519","value":"..."}) or a
+// base64 ed25519 key. Only public keys are accepted, so that the meters can be
+// registered without their private keys.
+func ParseMeterPubKey(s string) (crypto.PubKey, error) {
+	if strings.HasPrefix(strings.TrimSpace(s), "{") {
+		// amino panics if the type is not a crypto.PubKey
+		var envelope struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal([]byte(s), &envelope); err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(envelope.Type, "tendermint/PubKey") {
+			return nil, fmt.Errorf("expected a public key, got %q", envelope.Type)
+		}
+		var pubKey crypto.PubKey
+		if err := cdc.UnmarshalJSON([]byte(s), &pubKey); err != nil {
+			return nil, err
+		}
+		return pubKey, nil
+	}
+
+	bz, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) != ed25519.PubKeyEd25519Size {
+		return nil, fmt.Errorf("expected %d bytes, got %d", ed25519.PubKeyEd25519Size, len(bz))
+	}
+	var pubKey ed25519.PubKeyEd25519
+	copy(pubKey[:], bz)
+	return pubKey, nil
+}
+
+// GenesisBalance is a starting balance of the genesis app_state.
+type GenesisBalance struct {
+	Account string `json:"account"`
+	Amount  Amount `json:"amount"`
+}
+
+// ParseGenesisState decodes the app_state of a genesis document. An empty
+// app_state is an empty genesis state.
+func ParseGenesisState(appState []byte) (GenesisState, error) {
+	var gs GenesisState
+	if len(appState) == 0 {
+		return gs, nil
+	}
+	if err := cdc.UnmarshalJSON(appState, &gs); err != nil {
+		return gs, err
+	}
+	return gs, gs.ValidateBasic()
+}
+
+// MarshalAppState returns the app_state of a genesis document.
+func (gs GenesisState) MarshalAppState() (json.RawMessage, error) {
+	return cdc.MarshalJSONIndent(gs, "", "  ")
+}
+
+// ValidateBasic checks the consistency of the genesis state.
+func (gs GenesisState) ValidateBasic() error {
+	if len(gs.Admins) > 0 {
+		g := Governance{Admins: gs.Admins, Threshold: gs.Threshold}
+		if err := g.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid governance: %v", err)
+		}
+	} else if gs.Threshold != 0 {
+		return errors.New("threshold without admins")
+	}
+	if gs.MaxValidatorPower < 0 {
+		return fmt.Errorf("negative max_validator_power %d", gs.MaxValidatorPower)
+	}
+
+	meters := make(map[string]bool, len(gs.Meters))
+	for _, m := range gs.Meters {
+		if err := checkPubKey(m.PubKey); err != nil {
+			return fmt.Errorf("invalid meter: %v", err)
+		}
+		if meters[m.Address()] {
+			return fmt.Errorf("duplicate meter %s", m.Address())
+		}
+		meters[m.Address()] = true
+	}
+
+	accounts := make(map[string]bool, len(gs.Balances))
+	for _, b := range gs.Balances {
+		if !meters[b.Account] {
+			return fmt.Errorf("balance of %s, not an allowed meter", b.Account)
+		}
+		if accounts[b.Account] {
+			return fmt.Errorf("duplicate balance of %s", b.Account)
+		}
+		accounts[b.Account] = true
+		if b.Amount == 0 {
+			return fmt.Errorf("empty balance of %s", b.Account)
+		}
+	}
+	return nil
+}
+
+func meterKeyKey(meter string) []byte {
+	return []byte("meter-key_" + meter)
+}
+
+// NewSetAllowedMeters returns the action of a proposal which replaces the
+// allowed meters with the meters of pubKeys.
+func NewSetAllowedMeters(pubKeys ...crypto.PubKey) *types.LictSetAllowedMeters {
+	msg := &types.LictSetAllowedMeters{PubKeys: make([][]byte, len(pubKeys))}
+	for i, pubKey := range pubKeys {
+		msg.PubKeys[i] = cdc.MustMarshalBinaryBare(pubKey)
+	}
+	return msg
+}
+
+// decodeMeterKeys returns the public keys of the meters of msg, which must be
+// supported and distinct.
+func decodeMeterKeys(msg *types.LictSetAllowedMeters) ([]crypto.PubKey, error) {
+	if len(msg.PubKeys) == 0 {
+		return nil, errors.New("no meters")
+	}
+	pubKeys := make([]crypto.PubKey, len(msg.PubKeys))
+	meters := make(map[string]bool, len(msg.PubKeys))
+	for i, bz := range msg.PubKeys {
+		if err := cdc.UnmarshalBinaryBare(bz, &pubKeys[i]); err != nil {
+			return nil, fmt.Errorf("invalid meter key: %v", err)
+		}
+		if err := checkPubKey(pubKeys[i]); err != nil {
+			return nil, fmt.Errorf("invalid meter key: %v", err)
+		}
+		meter := pubKeys[i].Address().String()
+		if meters[meter] {
+			return nil, fmt.Errorf("duplicate meter %s", meter)
+		}
+		meters[meter] = true
+	}
+	return pubKeys, nil
+}
+
+// saveMeters registers the public keys of the meters and replaces the allowed
+// meters with them.
+func (app *Application) saveMeters(pubKeys []crypto.PubKey) types.ResponseDeliverTx {
+	meters := make([]string, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		meters[i] = pubKey.Address().String()
+		app.SetKVOnDB(meterKeyKey(meters[i]), cdc.MustMarshalJSON(pubKey))
+	}
+	return app.SetKVOnDB([]byte("allowed"), []byte(strings.Join(meters, ",")))
+}
+
+// LoadMeterKey returns the public key registered for meter, if any.
+func (app *Application) LoadMeterKey(meter string) (crypto.PubKey, bool) {
+	bz, err := app.state.db.Get(prefixKey(meterKeyKey(meter)))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nil, false
+	}
+	var pubKey crypto.PubKey
+	if err := cdc.UnmarshalJSON(bz, &pubKey); err != nil {
+		panic(err)
+	}
+	return pubKey, true
+}
+
+// InitChain loads the genesis app_state and stores the genesis validators. A
+// chain without admins can not be governed. Transactions must be signed for the
+// chain ID of the genesis.
+func (app *Application) InitChain(req types.RequestInitChain) types.ResponseInitChain {
+	genesis, err := ParseGenesisState(req.AppStateBytes)
+	if err != nil {
+		panic(fmt.Sprintf("invalid app_state: %v", err))
+	}
+	app.state.ChainID = req.ChainId
+
+	if len(genesis.Admins) > 0 {
+		app.saveGovernance(Governance{Admins: genesis.Admins, Threshold: genesis.Threshold})
+	}
+	if genesis.MaxValidatorPower > 0 {
+		app.SetKVOnDB(maxValidatorPowerKey, []byte(strconv.FormatInt(genesis.MaxValidatorPower, 10)))
+	}
+
+	if len(genesis.Meters) > 0 {
+		pubKeys := make([]crypto.PubKey, len(genesis.Meters))
+		for i, m := range genesis.Meters {
+			pubKeys[i] = m.PubKey
+		}
+		app.saveMeters(pubKeys)
+	}
+	for _, b := range genesis.Balances {
+		app.SetKVOnDB(balanceKey(b.Account), []byte(b.Amount.String()))
+	}
+
+	app.initValidators(req.Validators)
+	return types.ResponseInitChain{}
+}
@@ -0,0 +1,120 @@
+package tenderlic_kvstore
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/code"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+)
+
+func TestGenesisState(t *testing.T) {
+	kvstore := NewApplication()
+	admin := ed25519.GenPrivKey()
+	meter1, meter2 := ed25519.GenPrivKey(), secp256k1.GenPrivKey()
+	id1 := meter1.PubKey().Address().String()
+	id2 := meter2.PubKey().Address().String()
+
+	kvstore.InitChain(appStateRequest(t, GenesisState{
+		Admins:    []string{admin.PubKey().Address().String()},
+		Threshold: 1,
+		Meters:    []GenesisMeter{{PubKey: meter1.PubKey()}, {PubKey: meter2.PubKey()}},
+		Balances:  []GenesisBalance{{Account: id1, Amount: 10}},
+	}))
+	kvstore.Commit()
+
+	query := func(key string) string {
+		return string(kvstore.Query(types.RequestQuery{Data: []byte(key)}).Value)
+	}
+	assert.Equal(t, id1+","+id2, query("allowed"))
+	assert.Equal(t, "10", queryBalance(t, kvstore, admin, id1))
+	pubKey, ok := kvstore.LoadMeterKey(id2)
+	require.True(t, ok)
+	assert.Equal(t, meter2.PubKey(), pubKey)
+	_, ok = kvstore.LoadMeterKey(admin.PubKey().Address().String())
+	assert.False(t, ok)
+
+	// the genesis balances can be spent without admin transactions
+	res := kvstore.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, kvstore, meter1, transferTx(id2, 4))})
+	require.Equal(t, code.CodeTypeOK, res.Code, res)
+	// the queries only see the committed state
+	assert.Equal(t, "10", queryBalance(t, kvstore, admin, id1))
+	kvstore.Commit()
+	assert.Equal(t, "6", queryBalance(t, kvstore, admin, id1))
+	assert.Equal(t, "4", queryBalance(t, kvstore, admin, id2))
+}
+
+func TestGenesisStateValidation(t *testing.T) {
+	admin := ed25519.GenPrivKey().PubKey().Address().String()
+	meter := ed25519.GenPrivKey().PubKey()
+
+	invalid := map[string]GenesisState{
+		"threshold without admins": {Threshold: 1},
+		"unreachable threshold":    {Admins: []string{admin}, Threshold: 2},
+		"negative validator power": {MaxValidatorPower: -1},
+		"missing meter key":        {Meters: []GenesisMeter{{}}},
+		"unsupported meter key":    {Meters: []GenesisMeter{{PubKey: sr25519.GenPrivKey().PubKey()}}},
+		"duplicate meter":          {Meters: []GenesisMeter{{PubKey: meter}, {PubKey: meter}}},
+		"balance of unknown meter": {Balances: []GenesisBalance{{Account: admin, Amount: 1}}},
+		"empty balance": {
+			Meters:   []GenesisMeter{{PubKey: meter}},
+			Balances: []GenesisBalance{{Account: meter.Address().String()}},
+		},
+		"duplicate balance": {
+			Meters: []GenesisMeter{{PubKey: meter}},
+			Balances: []GenesisBalance{
+				{Account: meter.Address().String(), Amount: 1},
+				{Account: meter.Address().String(), Amount: 1},
+			},
+		},
+	}
+	for name, gs := range invalid {
+		assert.Error(t, gs.ValidateBasic(), name)
+		req := appStateRequest(t, gs)
+		assert.Panics(t, func() { NewApplication().InitChain(req) }, name)
+	}
+
+	gs, err := ParseGenesisState(nil)
+	require.NoError(t, err)
+	assert.Equal(t, GenesisState{}, gs)
+	_, err = ParseGenesisState([]byte(`{"admins": 1}`))
+	assert.Error(t, err)
+}
+
+func TestParseMeterPubKey(t *testing.T) {
+	edKey := ed25519.GenPrivKey().PubKey().(ed25519.PubKeyEd25519)
+	secpKey := secp256k1.GenPrivKey().PubKey()
+
+	testCases := []struct {
+		name    string
+		s       string
+		want    crypto.PubKey
+		wantErr bool
+	}{
+		{"base64 ed25519", base64.StdEncoding.EncodeToString(edKey[:]), edKey, false},
+		{"amino JSON ed25519", string(cdc.MustMarshalJSON(edKey)), edKey, false},
+		{"amino JSON secp256k1", string(cdc.MustMarshalJSON(secpKey)), secpKey, false},
+		{"invalid base64", "not base64!", nil, true},
+		{"short key", base64.StdEncoding.EncodeToString([]byte("short")), nil, true},
+		{"private key", string(cdc.MustMarshalJSON(ed25519.GenPrivKey())), nil, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pubKey, err := ParseMeterPubKey(tc.s)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, pubKey)
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package tenderlic_kvstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/tendermint/tendermint/abci/example/code"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+var (
+	governanceKey     = []byte("governance")
+	nextProposalIDKey = []byte("next-proposal")
+)
+
+// Governance is the set of community admins. A proposal is executed once
+// approved by Threshold admins.
+type Governance struct {
+	Admins    []string `json:"admins"`
+	Threshold uint32   `json:"threshold"`
+}
+
+// ValidateBasic checks that the threshold can be reached by distinct admins.
+func (g Governance) ValidateBasic() error {
+	if len(g.Admins) == 0 {
+		return errors.New("no admins")
+	}
+	seen := make(map[string]bool, len(g.Admins))
+	for _, admin := range g.Admins {
+		if admin == "" {
+			return errors.New("empty admin")
+		}
+		if seen[admin] {
+			return fmt.Errorf("duplicate admin %s", admin)
+		}
+		seen[admin] = true
+	}
+	if g.Threshold == 0 || int(g.Threshold) > len(g.Admins) {
+		return fmt.Errorf("threshold %d not in [1, %d]", g.Threshold, len(g.Admins))
+	}
+	return nil
+}
+
+// IsAdmin returns true if account is one of the admins.
+func (g Governance) IsAdmin(account string) bool {
+	for _, admin := range g.Admins {
+		if admin == account {
+			return true
+		}
+	}
+	return false
+}
+
+// Approvals returns the number of approvals of p given by current admins.
+func (g Governance) Approvals(p Proposal) uint32 {
+	var n uint32
+	for _, approval := range p.Approvals {
+		if g.IsAdmin(approval) {
+			n++
+		}
+	}
+	return n
+}
+
+// Proposal is an admin action waiting for the approval of the admins.
+type Proposal struct {
+	ID        uint64   `json:"id"`
+	Proposer  string   `json:"proposer"`
+	Action    []byte   `json:"action"` // encoded types.LictProposal
+	Approvals []string `json:"approvals"`
+	Executed  bool     `json:"executed"`
+}
+
+// IsApprovedBy returns true if account approved the proposal.
+func (p Proposal) IsApprovedBy(account string) bool {
+	for _, approval := range p.Approvals {
+		if approval == account {
+			return true
+		}
+	}
+	return false
+}
+
+func proposalKey(id uint64) []byte {
+	return []byte(fmt.Sprintf("proposal_%d", id))
+}
+
+// LoadGovernance returns the governance stored on chain, without admins
+// before InitChain.
+func (app *Application) LoadGovernance() Governance {
+	var g Governance
+	bz, err := app.state.db.Get(prefixKey(governanceKey))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return g
+	}
+	if err := json.Unmarshal(bz, &g); err != nil {
+		panic(err)
+	}
+	return g
+}
+
+func (app *Application) saveGovernance(g Governance) types.ResponseDeliverTx {
+	bz, err := json.Marshal(g)
+	if err != nil {
+		panic(err)
+	}
+	return app.SetKVOnDB(governanceKey, bz)
+}
+
+// LoadProposal returns the proposal with the given id, if any.
+func (app *Application) LoadProposal(id uint64) (Proposal, bool) {
+	var p Proposal
+	bz, err := app.state.db.Get(prefixKey(proposalKey(id)))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return p, false
+	}
+	if err := json.Unmarshal(bz, &p); err != nil {
+		panic(err)
+	}
+	return p, true
+}
+
+func (app *Application) saveProposal(p Proposal) types.ResponseDeliverTx {
+	bz, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return app.SetKVOnDB(proposalKey(p.ID), bz)
+}
+
+func (app *Application) nextProposalID() uint64 {
+	var id uint64
+	if bz, _ := app.state.db.Get(prefixKey(nextProposalIDKey)); bz != nil {
+		id, _ = strconv.ParseUint(string(bz), 10, 64)
+	}
+	return id
+}
+
+// CheckAdmin returns true if sender is one of the community admins.
+func (app *Application) CheckAdmin(sender string) bool {
+	return app.LoadGovernance().IsAdmin(sender)
+}
+
+// validatePropose checks a proposal before storing it. The effects of the
+// action are checked when it is executed.
+func (app *Application) validatePropose(sender string, msg *types.LictPropose) (uint32, string) {
+	if !app.CheckAdmin(sender) {
+		return code.CodeTypeUnauthorized, "ACCESS DENIED! You are not a community admin"
+	}
+	if msg.Proposal == nil {
+		return code.CodeTypeBadRequest, "Empty proposal"
+	}
+	switch action := msg.Proposal.Action.(type) {
+	case *types.LictProposal_Mint:
+		if action.Mint.Amount == 0 {
+			return code.CodeNotPositiveAmount, "The amount must be positive"
+		}
+	case *types.LictProposal_SetAllowedMeters:
+		if _, err := decodeMeterKeys(action.SetAllowedMeters); err != nil {
+			return code.CodeTypeBadRequest, err.Error()
+		}
+	case *types.LictProposal_SetValidator:
+		return app.validateSetValidator(action.SetValidator)
+	case *types.LictProposal_SetQueryPolicy:
+		if err := validateSetQueryPolicy(action.SetQueryPolicy); err != nil {
+			return code.CodeTypeBadRequest, err.Error()
+		}
+	case *types.LictProposal_SetAdmins:
+		g := Governance{Admins: action.SetAdmins.Admins, Threshold: action.SetAdmins.Threshold}
+		if err := g.ValidateBasic(); err != nil {
+			return code.CodeTypeBadRequest, fmt.Sprintf("Invalid admins: %v", err)
+		}
+	default:
+		return code.CodeTypeBadRequest, "Empty proposal"
+	}
+	return code.CodeTypeOK, ""
+}
+
+// validateApprove checks that sender can approve a pending proposal.
+func (app *Application) validateApprove(sender string, msg *types.LictApprove) (uint32, string) {
+	if !app.CheckAdmin(sender) {
+		return code.CodeTypeUnauthorized, "ACCESS DENIED! You are not a community admin"
+	}
+	p, ok := app.LoadProposal(msg.ProposalId)
+	switch {
+	case !ok:
+		return code.CodeTypeBadRequest, fmt.Sprintf("Unknown proposal %d", msg.ProposalId)
+	case p.Executed:
+		return code.CodeTypeBadRequest, fmt.Sprintf("Proposal %d already executed", msg.ProposalId)
+	case p.IsApprovedBy(sender):
+		return code.CodeTypeBadRequest, fmt.Sprintf("Proposal %d already approved by %s", msg.ProposalId, sender)
+	}
+	return code.CodeTypeOK, ""
+}
+
+// validateExecute checks that a proposal reached the approvals threshold.
+func (app *Application) validateExecute(sender string, msg *types.LictExecute) (uint32, string) {
+	g := app.LoadGovernance()
+	if !g.IsAdmin(sender) {
+		return code.CodeTypeUnauthorized, "ACCESS DENIED! You are not a community admin"
+	}
+	p, ok := app.LoadProposal(msg.ProposalId)
+	switch {
+	case !ok:
+		return code.CodeTypeBadRequest, fmt.Sprintf("Unknown proposal %d", msg.ProposalId)
+	case p.Executed:
+		return code.CodeTypeBadRequest, fmt.Sprintf("Proposal %d already executed", msg.ProposalId)
+	case g.Approvals(p) < g.Threshold:
+		return code.CodeTypeUnauthorized,
+			fmt.Sprintf("Proposal %d approved by %d admins, %d required", msg.ProposalId, g.Approvals(p), g.Threshold)
+	}
+	return code.CodeTypeOK, ""
+}
+
+func (app *Application) CheckTxPropose(sender string, msg *types.LictPropose) types.ResponseCheckTx {
+	logger.Info(fmt.Sprintf("Governance proposal transaction"))
+	c, log := app.validatePropose(sender, msg)
+	if c != code.CodeTypeOK {
+		logger.Warning(log)
+	}
+	return types.ResponseCheckTx{Code: c, Log: log, GasWanted: 1}
+}
+
+func (app *Application) CheckTxApprove(sender string, msg *types.LictApprove) types.ResponseCheckTx {
+	logger.Info(fmt.Sprintf("Governance approval transaction"))
+	c, log := app.validateApprove(sender, msg)
+	if c != code.CodeTypeOK {
+		logger.Warning(log)
+	}
+	return types.ResponseCheckTx{Code: c, Log: log, GasWanted: 1}
+}
+
+func (app *Application) CheckTxExecute(sender string, msg *types.LictExecute) types.ResponseCheckTx {
+	logger.Info(fmt.Sprintf("Governance execution transaction"))
+	c, log := app.validateExecute(sender, msg)
+	if c != code.CodeTypeOK {
+		logger.Warning(log)
+	}
+	return types.ResponseCheckTx{Code: c, Log: log, GasWanted: 1}
+}
+
+// DeliverTxPropose stores a new proposal, approved by the proposer. The id of
+// the proposal is returned as data.
+func (app *Application) DeliverTxPropose(sender string, msg *types.LictPropose) types.ResponseDeliverTx {
+	if c, log := app.validatePropose(sender, msg); c != code.CodeTypeOK {
+		return types.ResponseDeliverTx{Code: c, Log: log}
+	}
+	action, err := msg.Proposal.Marshal()
+	if err != nil {
+		return types.ResponseDeliverTx{Code: code.CodeTypeEncodingError, Log: err.Error()}
+	}
+
+	id := app.nextProposalID()
+	app.SetKVOnDB(nextProposalIDKey, []byte(strconv.FormatUint(id+1, 10)))
+	res := app.saveProposal(Proposal{
+		ID:        id,
+		Proposer:  sender,
+		Action:    action,
+		Approvals: []string{sender},
+	})
+	res.Data = []byte(strconv.FormatUint(id, 10))
+	res.Events = []types.Event{proposalEvent("propose", id, sender)}
+	return res
+}
+
+func (app *Application) DeliverTxApprove(sender string, msg *types.LictApprove) types.ResponseDeliverTx {
+	if c, log := app.validateApprove(sender, msg); c != code.CodeTypeOK {
+		return types.ResponseDeliverTx{Code: c, Log: log}
+	}
+	p, _ := app.LoadProposal(msg.ProposalId)
+	p.Approvals = append(p.Approvals, sender)
+	res := app.saveProposal(p)
+	res.Events = []types.Event{proposalEvent("approve", p.ID, sender)}
+	return res
+}
+
+// DeliverTxExecute applies the action of an approved proposal. A proposal
+// whose action fails stays pending.
+func (app *Application) DeliverTxExecute(sender string, msg *types.LictExecute) types.ResponseDeliverTx {
+	if c, log := app.validateExecute(sender, msg); c != code.CodeTypeOK {
+		return types.ResponseDeliverTx{Code: c, Log: log}
+	}
+	p, _ := app.LoadProposal(msg.ProposalId)
+	var proposal types.LictProposal
+	if err := proposal.Unmarshal(p.Action); err != nil {
+		return types.ResponseDeliverTx{Code: code.CodeTypeEncodingError, Log: err.Error()}
+	}
+
+	var res types.ResponseDeliverTx
+	switch action := proposal.Action.(type) {
+	case *types.LictProposal_Mint:
+		res = app.DeliverTxMint(action.Mint)
+	case *types.LictProposal_SetAllowedMeters:
+		res = app.DeliverTxAllowed(action.SetAllowedMeters)
+	case *types.LictProposal_SetAdmins:
+		res = app.saveGovernance(Governance{Admins: action.SetAdmins.Admins, Threshold: action.SetAdmins.Threshold})
+	case *types.LictProposal_SetValidator:
+		res = app.DeliverTxSetValidator(action.SetValidator)
+	case *types.LictProposal_SetQueryPolicy:
+		res = app.DeliverTxSetQueryPolicy(action.SetQueryPolicy)
+	default:
+		res = types.ResponseDeliverTx{Code: code.CodeTypeBadRequest}
+	}
+	if res.Code != code.CodeTypeOK {
+		return res
+	}
+
+	p.Executed = true
+	events := append([]types.Event{proposalEvent("execute", p.ID, sender)}, res.Events...)
+	res = app.saveProposal(p)
+	res.Events = events
+	return res
+}
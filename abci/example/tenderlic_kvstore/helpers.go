@@ -9,8 +9,8 @@ import (
 // from the input value
 func RandVal(i int) types.ValidatorUpdate {
 	pubkey := tmrand.Bytes(32)
-	power := tmrand.Uint16() + 1
-	v := types.Ed25519ValidatorUpdate(pubkey, int64(power))
+	power := tmrand.Int63n(DefaultMaxValidatorPower) + 1
+	v := types.Ed25519ValidatorUpdate(pubkey, power)
 	return v
 }
 
@@ -0,0 +1,76 @@
+package tenderlic_kvstore
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tendermint/tendermint/abci/example/code"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+func nonceKey(account string) []byte {
+	return []byte(fmt.Sprintf("nonce_%s", account))
+}
+
+// ReadNonceInDB returns the nonce expected in the next transaction of account.
+func (app *Application) ReadNonceInDB(account string) uint64 {
+	var nonce uint64
+	if nonceRaw, _ := app.state.db.Get(prefixKey(nonceKey(account))); nonceRaw != nil {
+		nonce, _ = strconv.ParseUint(string(nonceRaw), 10, 64)
+	}
+	return nonce
+}
+
+// checkNonce compares the nonce of a transaction with the expected one.
+func checkNonce(expected, nonce uint64) uint32 {
+	switch {
+	case nonce < expected:
+		logger.Warning(fmt.Sprintf("Stale nonce %d, expected %d", nonce, expected))
+		return code.CodeStaleNonce
+	case nonce > expected:
+		logger.Warning(fmt.Sprintf("Future nonce %d, expected %d", nonce, expected))
+		return code.CodeFutureNonce
+	default:
+		return code.CodeTypeOK
+	}
+}
+
+// CheckTxNonce checks the nonce of tx against the mempool view of the sender
+// account, which includes the transactions already accepted by CheckTx since
+// the last commit.
+func (app *Application) CheckTxNonce(tx SignedTx) types.ResponseCheckTx {
+	sender := tx.Sender()
+	expected, ok := app.checkNonces[sender]
+	if !ok {
+		expected = app.ReadNonceInDB(sender)
+	}
+	if c := checkNonce(expected, tx.Nonce); c != code.CodeTypeOK {
+		return types.ResponseCheckTx{
+			Code:      c,
+			Log:       fmt.Sprintf("Invalid nonce %d, expected %d", tx.Nonce, expected),
+			GasWanted: 1}
+	}
+	return types.ResponseCheckTx{Code: code.CodeTypeOK, GasWanted: 1}
+}
+
+// DeliverTxNonce checks the nonce of tx against the state and increments it.
+func (app *Application) DeliverTxNonce(tx SignedTx) types.ResponseDeliverTx {
+	sender := tx.Sender()
+	expected := app.ReadNonceInDB(sender)
+	if c := checkNonce(expected, tx.Nonce); c != code.CodeTypeOK {
+		return types.ResponseDeliverTx{
+			Code: c,
+			Log:  fmt.Sprintf("Invalid nonce %d, expected %d", tx.Nonce, expected)}
+	}
+	return app.SetKVOnDB(nonceKey(sender), []byte(strconv.FormatUint(expected+1, 10)))
+}
+
+// GetNonce returns the nonce expected in the next transaction of the account
+// given in the query data.
+func (app *Application) GetNonce(reqQuery types.RequestQuery) (resQuery types.ResponseQuery) {
+	resQuery.Key = reqQuery.Data
+	resQuery.Value = []byte(strconv.FormatUint(app.ReadNonceInDB(string(reqQuery.Data)), 10))
+	resQuery.Log = "Next nonce"
+	resQuery.Height = app.state.Height
+	return resQuery
+}
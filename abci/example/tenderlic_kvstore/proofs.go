@@ -0,0 +1,87 @@
+package tenderlic_kvstore
+
+import (
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// StoreName is the name of the store holding the key-value pairs of the app.
+// Proven queries are addressed to "/store/<StoreName>/key", which is the path
+// format verified by the light client proxy.
+const StoreName = "tenderlic"
+
+// storeSnapshot is the store as of the last commit, with the proofs of its
+// values against the app hash. Queries are served from it, so that the values
+// and proofs match the returned height even while a block is being delivered.
+type storeSnapshot struct {
+	// db holds the values under prefixKey, like the state db
+	db         *dbm.MemDB
+	values     map[string][]byte
+	proofs     map[string]*merkle.SimpleProof
+	storeProof *merkle.SimpleProof
+	appHash    []byte
+}
+
+// storeMap returns every key-value pair stored behind prefixKey, the keys
+// without the prefix.
+func storeMap(db dbm.DB) map[string][]byte {
+	end := make([]byte, len(kvPairPrefixKey))
+	copy(end, kvPairPrefixKey)
+	end[len(end)-1]++
+
+	itr, err := db.Iterator(kvPairPrefixKey, end)
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	m := make(map[string][]byte)
+	for ; itr.Valid(); itr.Next() {
+		m[string(itr.Key()[len(kvPairPrefixKey):])] = itr.Value()
+	}
+	return m
+}
+
+// snapshotStore hashes the store into a two levels simple merkle tree: the
+// root of the store map is the value of StoreName in the top-level map, so
+// that the proofs follow the /store/<StoreName>/key layout.
+//
+// NOTE: the whole state is hashed at every commit, which is fine for the size
+// of a community but linear in the number of stored keys. Queries don't hash
+// anything.
+func snapshotStore(db dbm.DB) *storeSnapshot {
+	values := storeMap(db)
+	// SimpleProofsFromMap doesn't support an empty map
+	storeRoot, proofs := merkle.SimpleHashFromMap(values), map[string]*merkle.SimpleProof{}
+	if len(values) > 0 {
+		storeRoot, proofs, _ = merkle.SimpleProofsFromMap(values)
+	}
+	appHash, storeProofs, _ := merkle.SimpleProofsFromMap(map[string][]byte{StoreName: storeRoot})
+	snapshotDB := dbm.NewMemDB()
+	for key, value := range values {
+		snapshotDB.SetNoLock(prefixKey([]byte(key)), value)
+	}
+	return &storeSnapshot{
+		db:         snapshotDB,
+		values:     values,
+		proofs:     proofs,
+		storeProof: storeProofs[StoreName],
+		appHash:    appHash,
+	}
+}
+
+// prove returns the value stored at key and its proof against the app hash,
+// or nil if the key is not stored.
+func (s *storeSnapshot) prove(key []byte) ([]byte, *merkle.Proof) {
+	keyProof, ok := s.proofs[string(key)]
+	if !ok {
+		return nil, nil
+	}
+	return s.values[string(key)], &merkle.Proof{
+		Ops: []merkle.ProofOp{
+			merkle.NewSimpleValueOp(key, keyProof).ProofOp(),
+			merkle.NewSimpleValueOp([]byte(StoreName), s.storeProof).ProofOp(),
+		},
+	}
+}
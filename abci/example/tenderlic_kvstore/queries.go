@@ -4,21 +4,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/tendermint/tendermint/abci/example/code"
 	"github.com/tendermint/tendermint/abci/types"
 )
 
-func (app *Application) checkQueryAllowance(storedData string, meterId string) (res bool) {
-	storedDataArr := strings.Split(storedData, ",")
-
-	if app.Contains(storedDataArr, meterId) {
-		return true
-	} else {
-		logger.Warning(fmt.Sprintf("Access unauthorized: Meter \"%s\" not allowed to perform queries", meterId))
-		return false
-	}
-}
-
-func (app *Application) GetBalance(reqQuery types.RequestQuery, meter string) (resQuery types.ResponseQuery) {
+// GetBalance returns the stored balance of a meter.
+func (app *Application) GetBalance(reqQuery types.RequestQuery) (resQuery types.ResponseQuery) {
 	value, _ := app.state.db.Get(prefixKey(reqQuery.Data))
 
 	if value == nil {
@@ -26,39 +17,21 @@ func (app *Application) GetBalance(reqQuery types.RequestQuery, meter string) (r
 		resQuery.Log = "Value not stored"
 		resQuery.Value = nil
 	} else {
-		if app.CheckAdmin() {
-			resQuery.Log = "Stored value"
-			resQuery.Value = value
-		} else {
-			// get the MAC hash
-			if app.checkMacAddress(meter) {
-				resQuery.Log = "Stored value"
-				resQuery.Value = value
-			} else {
-				logger.Warning(fmt.Sprintf("ACCESS DENIED! You are not allowed to query the balance of meter %s", meter))
-				resQuery.Log = "Not authorized"
-				resQuery.Value = nil
-			}
-		}
+		resQuery.Log = "Stored value"
+		resQuery.Value = value
 	}
 	return resQuery
 }
 
-func (app *Application) GetTimeRelatedValues(reqQuery types.RequestQuery, meter string) (resQuery types.ResponseQuery) {
-	allowedMeters := app.GetAllowedMeters()
+func (app *Application) GetTimeRelatedValues(reqQuery types.RequestQuery) (resQuery types.ResponseQuery) {
 	value, _ := app.state.db.Get(prefixKey(reqQuery.Data))
 
-	if app.checkQueryAllowance(string(allowedMeters), meter) == false {
-		resQuery.Value = nil
-		resQuery.Log = "Meter not authorized"
+	if value == nil {
+		resQuery.Log = "Not available value"
 	} else {
-		if value == nil {
-			resQuery.Log = "Not available value"
-		} else {
-			resQuery.Log = "Stored value"
-		}
-		resQuery.Value = value
+		resQuery.Log = "Stored value"
 	}
+	resQuery.Value = value
 	return resQuery
 }
 
@@ -76,25 +49,61 @@ func (app *Application) GetSingleValue(reqQuery types.RequestQuery) (resQuery ty
 	return resQuery
 }
 
-func (app *Application) Query(reqQuery types.RequestQuery) (resQuery types.ResponseQuery) {
+// Query serves every query from the store as of the last commit, so that a
+// returned value always matches the returned height, including the checks of
+// the query policies. The writes of the block being delivered are not visible.
+func (app *Application) Query(reqQuery types.RequestQuery) types.ResponseQuery {
+	committed := *app
+	committed.state.db = app.committed.db
+	return committed.query(reqQuery)
+}
+
+func (app *Application) query(reqQuery types.RequestQuery) (resQuery types.ResponseQuery) {
 	// Set the logger
 	app.SetLogger()
 
+	// Unwrap and authorize signed queries
+	reqQuery, c, log := app.authorizeQuery(reqQuery)
+	if c != code.CodeTypeOK {
+		logger.Warning(log)
+		return types.ResponseQuery{Code: c, Log: log, Height: app.state.Height}
+	}
+
 	rawStr := strings.ReplaceAll(string(prefixKey(reqQuery.Data)), "kvPairKey:", "")
 	keyInfo := strings.Split(rawStr, "_")
 
 	logger.Info(fmt.Sprintf("Performing query: %s", reqQuery.String()))
 
-	if keyInfo[0] == "admin" || keyInfo[0] == "allowed" {
+	switch reqQuery.Path {
+	case "/nonce":
+		return app.GetNonce(reqQuery)
+	case "/meter/range":
+		return app.GetReadingsRange(reqQuery)
+	case "/meter/aggregate":
+		return app.GetReadingsAggregate(reqQuery)
+	case "/validators":
+		return app.GetValidators(reqQuery)
+	}
+
+	if keyInfo[0] == "governance" || keyInfo[0] == "allowed" || keyInfo[0] == "proposal" ||
+		keyInfo[0] == "max-validator-power" || keyInfo[0] == "meter-key" ||
+		keyInfo[0] == "query-policy" {
 		resQuery = app.GetSingleValue(reqQuery)
 	} else if keyInfo[0] == "lict-balance" {
-		resQuery = app.GetBalance(reqQuery, keyInfo[1])
+		resQuery = app.GetBalance(reqQuery)
 	} else if len(keyInfo) == 3 {
-		resQuery = app.GetTimeRelatedValues(reqQuery, keyInfo[1])
+		resQuery = app.GetTimeRelatedValues(reqQuery)
 	} else {
 		logger.Warning(fmt.Sprintf("Query %s is not well formed", reqQuery.String()))
 		resQuery.Log = "Query not well formed"
 		resQuery.Value = nil
 	}
+
+	// Prove the value against the last app hash
+	if reqQuery.Prove && resQuery.Value != nil {
+		resQuery.Key = reqQuery.Data
+		resQuery.Value, resQuery.Proof = app.committed.prove(reqQuery.Data)
+	}
+	resQuery.Height = app.state.Height
 	return resQuery
 }
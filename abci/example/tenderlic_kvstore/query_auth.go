@@ -0,0 +1,220 @@
+package tenderlic_kvstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/abci/example/code"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// QueryPolicy sets who can read the results of a query path.
+type QueryPolicy string
+
+const (
+	// PolicyPublic queries need no signature
+	PolicyPublic QueryPolicy = "public"
+	// PolicyMeters queries must be signed by an allowed meter or an admin
+	PolicyMeters QueryPolicy = "meters"
+	// PolicyOwner queries must be signed by the meter they are about, or by an
+	// admin
+	PolicyOwner QueryPolicy = "owner"
+	// PolicyAdmins queries must be signed by an admin
+	PolicyAdmins QueryPolicy = "admins"
+
+	// QueryChallengeWindow is the number of blocks a signed query is valid for.
+	QueryChallengeWindow int64 = 10
+)
+
+// ValidateBasic returns an error if p is not a known policy.
+func (p QueryPolicy) ValidateBasic() error {
+	switch p {
+	case PolicyPublic, PolicyMeters, PolicyOwner, PolicyAdmins:
+		return nil
+	default:
+		return fmt.Errorf("unknown query policy %q", p)
+	}
+}
+
+// defaultQueryPolicies are the policies of the paths without a policy set by
+// the governance. Queries of the stored keys have the path /store/<key kind>.
+var defaultQueryPolicies = map[string]QueryPolicy{
+	"/store/meter":        PolicyOwner,
+	"/meter/range":        PolicyOwner,
+	"/meter/aggregate":    PolicyOwner,
+	"/store/lict-balance": PolicyOwner,
+}
+
+// SignedQuery is a query signed by the account reading the result, amino
+// encoded in RequestQuery.Data. The signature covers the path, the data and a
+// recent height, so that it can not be replayed for other queries or later
+// than QueryChallengeWindow blocks.
+type SignedQuery struct {
+	Path      string        `json:"path"`
+	Data      []byte        `json:"data"`
+	Height    int64         `json:"height"`
+	PubKey    crypto.PubKey `json:"pub_key"`
+	Signature []byte        `json:"signature"`
+}
+
+// SignBytes returns the bytes covered by the signature.
+func (q SignedQuery) SignBytes() []byte {
+	q.Signature = nil
+	return cdc.MustMarshalBinaryBare(q)
+}
+
+// Signer returns the account which signed the query.
+func (q SignedQuery) Signer() string {
+	return q.PubKey.Address().String()
+}
+
+// ValidateBasic checks the key type and the signature of the query.
+func (q SignedQuery) ValidateBasic() error {
+	if err := checkPubKey(q.PubKey); err != nil {
+		return err
+	}
+	if !q.PubKey.VerifyBytes(q.SignBytes(), q.Signature) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// MakeSignedQuery returns the RequestQuery.Data of a query of path with data,
+// signed by privKey at the last committed height.
+func MakeSignedQuery(privKey crypto.PrivKey, path string, data []byte, height int64) ([]byte, error) {
+	q := SignedQuery{Path: path, Data: data, Height: height, PubKey: privKey.PubKey()}
+	sig, err := privKey.Sign(q.SignBytes())
+	if err != nil {
+		return nil, err
+	}
+	q.Signature = sig
+	return cdc.MarshalBinaryBare(q)
+}
+
+// decodeSignedQuery returns the signed query of data, if any.
+func decodeSignedQuery(data []byte) (SignedQuery, bool) {
+	var q SignedQuery
+	if err := cdc.UnmarshalBinaryBare(data, &q); err != nil || q.PubKey == nil || len(q.Signature) == 0 {
+		return SignedQuery{}, false
+	}
+	return q, true
+}
+
+func queryPolicyKey(path string) []byte {
+	return []byte("query-policy_" + path)
+}
+
+// queryPolicyPath returns the path of the policy of a query.
+func queryPolicyPath(reqQuery types.RequestQuery) string {
+	switch reqQuery.Path {
+	case "/nonce", "/meter/range", "/meter/aggregate", "/validators":
+		return reqQuery.Path
+	}
+	return "/store/" + strings.Split(string(reqQuery.Data), "_")[0]
+}
+
+// queryOwner returns the meter or account a query is about, if any.
+func queryOwner(reqQuery types.RequestQuery) string {
+	switch reqQuery.Path {
+	case "/nonce":
+		return string(reqQuery.Data)
+	case "/meter/range", "/meter/aggregate":
+		var req struct {
+			Meter string `json:"meter"`
+		}
+		if err := json.Unmarshal(reqQuery.Data, &req); err != nil {
+			return ""
+		}
+		return req.Meter
+	case "/validators":
+		return ""
+	}
+	// <kind>_<owner>[_...]
+	if keyInfo := strings.Split(string(reqQuery.Data), "_"); len(keyInfo) > 1 {
+		return keyInfo[1]
+	}
+	return ""
+}
+
+// LoadQueryPolicy returns the policy of a query path.
+func (app *Application) LoadQueryPolicy(path string) QueryPolicy {
+	bz, err := app.state.db.Get(prefixKey(queryPolicyKey(path)))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) > 0 {
+		return QueryPolicy(bz)
+	}
+	if policy, ok := defaultQueryPolicies[path]; ok {
+		return policy
+	}
+	return PolicyPublic
+}
+
+// authorizeQuery unwraps a signed query and checks it against the policy of
+// its path. The returned request has the data of the signed query.
+func (app *Application) authorizeQuery(reqQuery types.RequestQuery) (types.RequestQuery, uint32, string) {
+	q, signed := decodeSignedQuery(reqQuery.Data)
+	if signed {
+		if err := q.ValidateBasic(); err != nil {
+			return reqQuery, code.CodeTypeUnauthorized, fmt.Sprintf("Invalid signed query: %v", err)
+		}
+		if q.Path != reqQuery.Path {
+			return reqQuery, code.CodeTypeUnauthorized, fmt.Sprintf("Query signed for path %s", q.Path)
+		}
+		if q.Height > app.state.Height || q.Height < app.state.Height-QueryChallengeWindow {
+			return reqQuery, code.CodeTypeUnauthorized,
+				fmt.Sprintf("Query signed at height %d, expected a height in [%d, %d]",
+					q.Height, app.state.Height-QueryChallengeWindow, app.state.Height)
+		}
+		reqQuery.Data = q.Data
+	}
+
+	policy := app.LoadQueryPolicy(queryPolicyPath(reqQuery))
+	if policy == PolicyPublic {
+		return reqQuery, code.CodeTypeOK, ""
+	}
+	if !signed {
+		return reqQuery, code.CodeTypeUnauthorized, fmt.Sprintf("Query of a path with the %s policy must be signed", policy)
+	}
+
+	signer := q.Signer()
+	if app.CheckAdmin(signer) {
+		return reqQuery, code.CodeTypeOK, ""
+	}
+	if !app.checkMeterKey(q) {
+		return reqQuery, code.CodeTypeUnauthorized, fmt.Sprintf("Query not signed with the registered key of meter %s", signer)
+	}
+	switch {
+	case policy == PolicyMeters && app.checkMeterAllowance(app.GetAllowedMeters(), signer):
+		return reqQuery, code.CodeTypeOK, ""
+	case policy == PolicyOwner && signer == queryOwner(reqQuery):
+		return reqQuery, code.CodeTypeOK, ""
+	}
+	return reqQuery, code.CodeTypeUnauthorized, fmt.Sprintf("Access denied to %s by the %s policy", signer, policy)
+}
+
+// checkMeterKey returns true if q is signed with the public key registered for
+// its signer, which must be a meter.
+func (app *Application) checkMeterKey(q SignedQuery) bool {
+	pubKey, ok := app.LoadMeterKey(q.Signer())
+	return ok && pubKey.Equals(q.PubKey) && pubKey.VerifyBytes(q.SignBytes(), q.Signature)
+}
+
+// DeliverTxSetQueryPolicy applies the query policy of an executed proposal.
+func (app *Application) DeliverTxSetQueryPolicy(msg *types.LictSetQueryPolicy) types.ResponseDeliverTx {
+	if err := validateSetQueryPolicy(msg); err != nil {
+		return types.ResponseDeliverTx{Code: code.CodeTypeBadRequest, Log: err.Error()}
+	}
+	return app.SetKVOnDB(queryPolicyKey(msg.Path), []byte(msg.Policy))
+}
+
+func validateSetQueryPolicy(msg *types.LictSetQueryPolicy) error {
+	if !strings.HasPrefix(msg.Path, "/") {
+		return fmt.Errorf("invalid query path %q", msg.Path)
+	}
+	return QueryPolicy(msg.Policy).ValidateBasic()
+}
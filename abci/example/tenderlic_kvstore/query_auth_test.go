@@ -0,0 +1,150 @@
+package tenderlic_kvstore
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/code"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+)
+
+func setQueryPolicyTx(path string, policy QueryPolicy) types.LictTx {
+	return proposeTx(types.LictProposal{Action: &types.LictProposal_SetQueryPolicy{
+		SetQueryPolicy: &types.LictSetQueryPolicy{Path: path, Policy: string(policy)}}})
+}
+
+func TestQueryAuthorization(t *testing.T) {
+	kvstore := NewApplication()
+	admin := ed25519.GenPrivKey()
+	meter1, meter2 := ed25519.GenPrivKey(), secp256k1.GenPrivKey()
+	id1 := meter1.PubKey().Address().String()
+
+	kvstore.InitChain(appStateRequest(t, GenesisState{
+		Admins:    []string{admin.PubKey().Address().String()},
+		Threshold: 1,
+		Meters:    []GenesisMeter{{PubKey: meter1.PubKey()}, {PubKey: meter2.PubKey()}},
+		Balances:  []GenesisBalance{{Account: id1, Amount: 10}},
+	}))
+	res := kvstore.DeliverTx(types.RequestDeliverTx{Tx: signTx(t, meter1, 0, measureTx(100, 7))})
+	require.Equal(t, code.CodeTypeOK, res.Code, res)
+	kvstore.Commit()
+
+	reading := fmt.Sprintf("meter_%s_%020d", id1, 100)
+	query := func(path, data string) types.ResponseQuery {
+		return kvstore.Query(types.RequestQuery{Path: path, Data: []byte(data)})
+	}
+	signedQuery := func(privKey crypto.PrivKey, path, data string, height int64) types.ResponseQuery {
+		bz, err := MakeSignedQuery(privKey, path, []byte(data), height)
+		require.NoError(t, err)
+		return kvstore.Query(types.RequestQuery{Path: path, Data: bz})
+	}
+
+	// the readings are read by their meter and by the admins
+	assert.Equal(t, code.CodeTypeUnauthorized, query("/store", reading).Code)
+	resQuery := signedQuery(meter1, "/store", reading, 1)
+	assert.Equal(t, code.CodeTypeOK, resQuery.Code, resQuery.Log)
+	assert.Equal(t, "7", string(resQuery.Value))
+	assert.Equal(t, code.CodeTypeUnauthorized, signedQuery(meter2, "/store", reading, 1).Code)
+	assert.Equal(t, "7", string(signedQuery(admin, "/store", reading, 1).Value))
+
+	// signed queries can not be replayed for another path or too late
+	bz, err := MakeSignedQuery(meter1, "/nonce", []byte(reading), 1)
+	require.NoError(t, err)
+	assert.Equal(t, code.CodeTypeUnauthorized, kvstore.Query(types.RequestQuery{Path: "/store", Data: bz}).Code)
+	assert.Equal(t, code.CodeTypeUnauthorized, signedQuery(meter1, "/store", reading, 2).Code)
+	for i := int64(0); i < QueryChallengeWindow; i++ {
+		kvstore.Commit()
+	}
+	assert.Equal(t, code.CodeTypeOK, signedQuery(meter1, "/store", reading, 1).Code)
+	kvstore.Commit()
+	assert.Equal(t, code.CodeTypeUnauthorized, signedQuery(meter1, "/store", reading, 1).Code)
+	height := kvstore.state.Height
+
+	// tampered queries
+	q, ok := decodeSignedQuery(bz)
+	require.True(t, ok)
+	q.Path, q.Height = "/store", height
+	assert.Equal(t, code.CodeTypeUnauthorized,
+		kvstore.Query(types.RequestQuery{Path: "/store", Data: cdc.MustMarshalBinaryBare(q)}).Code)
+
+	// the balances are read by their account and by the admins
+	assert.Equal(t, code.CodeTypeUnauthorized, query("/store", "lict-balance_"+id1).Code)
+	assert.Equal(t, "10", string(signedQuery(meter1, "/store", "lict-balance_"+id1, height).Value))
+	assert.Equal(t, code.CodeTypeUnauthorized, signedQuery(meter2, "/store", "lict-balance_"+id1, height).Code)
+
+	// the policies are changed by the governance
+	res = deliverProposal(t, kvstore, admin, setQueryPolicyTx("/store/lict-balance", PolicyAdmins))
+	require.Equal(t, code.CodeTypeOK, res.Code, res)
+	res = deliverProposal(t, kvstore, admin, setQueryPolicyTx("/store/meter", PolicyMeters))
+	require.Equal(t, code.CodeTypeOK, res.Code, res)
+	kvstore.Commit()
+	assert.Equal(t, "admins", string(query("/store", "query-policy_/store/lict-balance").Value))
+	assert.Equal(t, PolicyMeters, kvstore.LoadQueryPolicy("/store/meter"))
+
+	assert.Equal(t, code.CodeTypeUnauthorized, query("/store", "lict-balance_"+id1).Code)
+	assert.Equal(t, code.CodeTypeUnauthorized, signedQuery(meter1, "/store", "lict-balance_"+id1, height).Code)
+	assert.Equal(t, "10", string(signedQuery(admin, "/store", "lict-balance_"+id1, height).Value))
+	assert.Equal(t, "7", string(signedQuery(meter2, "/store", reading, height).Value))
+	assert.Equal(t, code.CodeTypeUnauthorized, signedQuery(ed25519.GenPrivKey(), "/store", reading, height).Code)
+
+	// invalid policies are rejected
+	resCheck := kvstore.CheckTx(types.RequestCheckTx{Tx: nextTx(t, kvstore, admin, setQueryPolicyTx("/store/meter", "everyone"))})
+	assert.Equal(t, code.CodeTypeBadRequest, resCheck.Code)
+	resCheck = kvstore.CheckTx(types.RequestCheckTx{Tx: nextTx(t, kvstore, admin, setQueryPolicyTx("store", PolicyPublic))})
+	assert.Equal(t, code.CodeTypeBadRequest, resCheck.Code)
+}
+
+func TestQueryMeterKeys(t *testing.T) {
+	kvstore := NewApplication()
+	admin := ed25519.GenPrivKey()
+	meter1, meter2 := ed25519.GenPrivKey(), secp256k1.GenPrivKey()
+	id2 := meter2.PubKey().Address().String()
+
+	kvstore.InitChain(genesisRequest(t, 1, admin))
+	reading := fmt.Sprintf("meter_%s_%020d", id2, 100)
+	signedQuery := func(privKey crypto.PrivKey, data string) types.ResponseQuery {
+		kvstore.Commit()
+		bz, err := MakeSignedQuery(privKey, "/store", []byte(data), kvstore.state.Height)
+		require.NoError(t, err)
+		return kvstore.Query(types.RequestQuery{Path: "/store", Data: bz})
+	}
+
+	// the meters set by the governance are registered with their keys
+	res := deliverProposal(t, kvstore, admin, allowedTx(meter1.PubKey(), meter2.PubKey()))
+	require.Equal(t, code.CodeTypeOK, res.Code, res)
+	pubKey, ok := kvstore.LoadMeterKey(id2)
+	require.True(t, ok)
+	assert.Equal(t, meter2.PubKey(), pubKey)
+	res = kvstore.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, kvstore, meter2, measureTx(100, 7))})
+	require.Equal(t, code.CodeTypeOK, res.Code, res)
+	resQuery := signedQuery(meter2, reading)
+	assert.Equal(t, code.CodeTypeOK, resQuery.Code, resQuery.Log)
+	assert.Equal(t, "7", string(resQuery.Value))
+
+	// an allowed meter without a registered key can not read anything
+	kvstore.SetKVOnDB(meterKeyKey(id2), nil)
+	assert.Equal(t, code.CodeTypeUnauthorized, signedQuery(meter2, reading).Code)
+	assert.Equal(t, "7", string(signedQuery(admin, reading).Value))
+
+	// every allowed meter needs a valid and distinct key
+	invalid := map[string]*types.LictSetAllowedMeters{
+		"no meters":        NewSetAllowedMeters(),
+		"duplicate meter":  NewSetAllowedMeters(meter1.PubKey(), meter1.PubKey()),
+		"invalid key":      {PubKeys: [][]byte{[]byte(id2)}},
+		"private key":      {PubKeys: [][]byte{cdc.MustMarshalBinaryBare(meter1)}},
+		"unsupported key":  NewSetAllowedMeters(sr25519.GenPrivKey().PubKey()),
+		"missing key type": {PubKeys: [][]byte{meter1.PubKey().Bytes()[4:]}},
+	}
+	for name, msg := range invalid {
+		lictTx := proposeTx(types.LictProposal{Action: &types.LictProposal_SetAllowedMeters{SetAllowedMeters: msg}})
+		resCheck := kvstore.CheckTx(types.RequestCheckTx{Tx: nextTx(t, kvstore, admin, lictTx)})
+		assert.Equal(t, code.CodeTypeBadRequest, resCheck.Code, name)
+	}
+}
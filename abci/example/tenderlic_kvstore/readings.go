@@ -0,0 +1,185 @@
+package tenderlic_kvstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/tendermint/tendermint/abci/example/code"
+	"github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// Default and maximum number of readings returned by a range query
+	defaultPerPage = 30
+	maxPerPage     = 100
+)
+
+// measureKey returns the key of a reading of meter. The timestamp is zero
+// padded, so that the readings of a meter are stored in chronological order.
+func measureKey(meter string, timestamp int64) []byte {
+	return []byte(fmt.Sprintf("meter_%s_%020d", meter, timestamp))
+}
+
+// Reading is a power measure of a meter.
+type Reading struct {
+	Timestamp int64 `json:"timestamp"`
+	Value     int64 `json:"value"`
+}
+
+// RangeRequest is the JSON data of a /meter/range query: the readings of
+// Meter in [From, To), To being unbounded if 0.
+type RangeRequest struct {
+	Meter   string `json:"meter"`
+	From    int64  `json:"from"`
+	To      int64  `json:"to"`
+	Page    int    `json:"page"`
+	PerPage int    `json:"per_page"`
+}
+
+// RangeResponse is the JSON value returned by a /meter/range query.
+type RangeResponse struct {
+	Readings   []Reading `json:"readings"`
+	TotalCount int       `json:"total_count"`
+}
+
+// AggregateRequest is the JSON data of a /meter/aggregate query: the readings
+// of Meter in [From, To) are grouped in intervals of Interval seconds
+// starting at From, or in a single interval if Interval is 0.
+type AggregateRequest struct {
+	Meter    string `json:"meter"`
+	From     int64  `json:"from"`
+	To       int64  `json:"to"`
+	Interval int64  `json:"interval"`
+}
+
+// Aggregate summarizes the readings of an interval. Total is a big.Int, since
+// the sum of int64 readings can overflow; it is encoded as a JSON number.
+type Aggregate struct {
+	From    int64    `json:"from"`
+	Count   int64    `json:"count"`
+	Total   *big.Int `json:"total"`
+	Average float64  `json:"average"`
+}
+
+// AggregateResponse is the JSON value returned by a /meter/aggregate query.
+// Intervals without readings are omitted.
+type AggregateResponse struct {
+	Aggregates []Aggregate `json:"aggregates"`
+}
+
+// iterateReadings calls fn, in chronological order, with the readings of
+// meter in [from, to).
+func (app *Application) iterateReadings(meter string, from, to int64, fn func(Reading)) {
+	if to == 0 {
+		to = math.MaxInt64
+	}
+	itr, err := app.state.db.Iterator(prefixKey(measureKey(meter, from)), prefixKey(measureKey(meter, to)))
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	prefixLen := len(prefixKey(measureKey(meter, 0))) - 20
+	for ; itr.Valid(); itr.Next() {
+		timestamp, err := strconv.ParseInt(string(itr.Key()[prefixLen:]), 10, 64)
+		if err != nil {
+			continue
+		}
+		value, err := strconv.ParseInt(string(itr.Value()), 10, 64)
+		if err != nil {
+			continue
+		}
+		fn(Reading{Timestamp: timestamp, Value: value})
+	}
+}
+
+// checkRange validates the time window of a query.
+func (app *Application) checkRange(from, to int64) (resQuery types.ResponseQuery, ok bool) {
+	if from < 0 || to < 0 || (to != 0 && to <= from) {
+		resQuery.Code = code.CodeTypeBadRequest
+		resQuery.Log = "Invalid time window"
+		return resQuery, false
+	}
+	return resQuery, true
+}
+
+// GetReadingsRange returns a page of the readings of a meter in a time window.
+func (app *Application) GetReadingsRange(reqQuery types.RequestQuery) (resQuery types.ResponseQuery) {
+	var req RangeRequest
+	if err := json.Unmarshal(reqQuery.Data, &req); err != nil {
+		resQuery.Code = code.CodeTypeEncodingError
+		resQuery.Log = fmt.Sprintf("Invalid range request: %v", err)
+		return resQuery
+	}
+	if resQuery, ok := app.checkRange(req.From, req.To); !ok {
+		return resQuery
+	}
+
+	perPage := req.PerPage
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	} else if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+	page := req.Page
+	if page <= 0 {
+		page = 1
+	}
+	skip := (page - 1) * perPage
+
+	res := RangeResponse{Readings: []Reading{}}
+	app.iterateReadings(req.Meter, req.From, req.To, func(r Reading) {
+		if res.TotalCount >= skip && len(res.Readings) < perPage {
+			res.Readings = append(res.Readings, r)
+		}
+		res.TotalCount++
+	})
+
+	resQuery.Value, _ = json.Marshal(res)
+	resQuery.Log = "Readings range"
+	return resQuery
+}
+
+// GetReadingsAggregate returns the count, total and average of the readings of
+// a meter per interval.
+func (app *Application) GetReadingsAggregate(reqQuery types.RequestQuery) (resQuery types.ResponseQuery) {
+	var req AggregateRequest
+	if err := json.Unmarshal(reqQuery.Data, &req); err != nil {
+		resQuery.Code = code.CodeTypeEncodingError
+		resQuery.Log = fmt.Sprintf("Invalid aggregate request: %v", err)
+		return resQuery
+	}
+	if resQuery, ok := app.checkRange(req.From, req.To); !ok {
+		return resQuery
+	}
+	if req.Interval < 0 {
+		resQuery.Code = code.CodeTypeBadRequest
+		resQuery.Log = "Invalid interval"
+		return resQuery
+	}
+
+	res := AggregateResponse{Aggregates: []Aggregate{}}
+	app.iterateReadings(req.Meter, req.From, req.To, func(r Reading) {
+		start := req.From
+		if req.Interval > 0 {
+			start += (r.Timestamp - req.From) / req.Interval * req.Interval
+		}
+		if n := len(res.Aggregates); n == 0 || res.Aggregates[n-1].From != start {
+			res.Aggregates = append(res.Aggregates, Aggregate{From: start, Total: new(big.Int)})
+		}
+		agg := &res.Aggregates[len(res.Aggregates)-1]
+		agg.Count++
+		agg.Total.Add(agg.Total, big.NewInt(r.Value))
+	})
+	for i := range res.Aggregates {
+		agg := &res.Aggregates[i]
+		agg.Average, _ = new(big.Float).Quo(new(big.Float).SetInt(agg.Total), big.NewFloat(float64(agg.Count))).Float64()
+	}
+
+	resQuery.Value, _ = json.Marshal(res)
+	resQuery.Log = "Readings aggregate"
+	return resQuery
+}
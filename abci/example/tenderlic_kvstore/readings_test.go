@@ -0,0 +1,96 @@
+package tenderlic_kvstore
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/abci/example/code"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func measureTx(timestamp, value int64) types.LictTx {
+	return types.LictTx{Msg: &types.LictTx_PowerMeasure{
+		PowerMeasure: &types.LictPowerMeasure{Timestamp: timestamp, Value: value}}}
+}
+
+func TestReadingsQueries(t *testing.T) {
+	kvstore := NewApplication()
+	admin := ed25519.GenPrivKey()
+	meter := ed25519.GenPrivKey()
+	meterID := meter.PubKey().Address().String()
+
+	kvstore.InitChain(genesisRequest(t, 1, admin))
+	res := deliverProposal(t, kvstore, admin, allowedTx(meter.PubKey()))
+	require.Equal(t, code.CodeTypeOK, res.Code)
+	// readings are delivered out of order, the timestamps have different
+	// number of digits
+	for i, ts := range []int64{900, 100, 1000, 50, 950, 1500} {
+		res = kvstore.DeliverTx(types.RequestDeliverTx{Tx: signTx(t, meter, uint64(i), measureTx(ts, ts/10))})
+		require.Equal(t, code.CodeTypeOK, res.Code)
+	}
+	kvstore.Commit()
+
+	signedQuery := func(path string, req interface{}) types.ResponseQuery {
+		data, err := json.Marshal(req)
+		require.NoError(t, err)
+		data, err = MakeSignedQuery(meter, path, data, 1)
+		require.NoError(t, err)
+		return kvstore.Query(types.RequestQuery{Path: path, Data: data})
+	}
+	rangeQuery := func(req RangeRequest) (RangeResponse, types.ResponseQuery) {
+		resQuery := signedQuery("/meter/range", req)
+		var res RangeResponse
+		if resQuery.Code == code.CodeTypeOK {
+			require.NoError(t, json.Unmarshal(resQuery.Value, &res))
+		}
+		return res, resQuery
+	}
+
+	rng, _ := rangeQuery(RangeRequest{Meter: meterID, From: 100, To: 1000})
+	assert.Equal(t, []Reading{{100, 10}, {900, 90}, {950, 95}}, rng.Readings)
+	assert.Equal(t, 3, rng.TotalCount)
+
+	rng, _ = rangeQuery(RangeRequest{Meter: meterID, Page: 2, PerPage: 4})
+	assert.Equal(t, []Reading{{1000, 100}, {1500, 150}}, rng.Readings)
+	assert.Equal(t, 6, rng.TotalCount)
+
+	_, resQuery := rangeQuery(RangeRequest{Meter: meterID, From: 1000, To: 100})
+	assert.Equal(t, code.CodeTypeBadRequest, resQuery.Code)
+	_, resQuery = rangeQuery(RangeRequest{Meter: "unknown"})
+	assert.Equal(t, code.CodeTypeUnauthorized, resQuery.Code)
+
+	resQuery = signedQuery("/meter/aggregate", AggregateRequest{Meter: meterID, From: 0, To: 1200, Interval: 500})
+	require.Equal(t, code.CodeTypeOK, resQuery.Code)
+	var agg AggregateResponse
+	require.NoError(t, json.Unmarshal(resQuery.Value, &agg))
+	assert.Equal(t, []Aggregate{
+		{From: 0, Count: 2, Total: big.NewInt(15), Average: 7.5},
+		{From: 500, Count: 2, Total: big.NewInt(185), Average: 92.5},
+		{From: 1000, Count: 1, Total: big.NewInt(100), Average: 100},
+	}, agg.Aggregates)
+
+	// negative timestamps can not be ordered
+	resCheck := kvstore.CheckTx(types.RequestCheckTx{Tx: signTx(t, meter, 6, measureTx(-1, 1))})
+	assert.Equal(t, code.CodeTypeBadRequest, resCheck.Code)
+
+	// the totals don't overflow
+	for i, ts := range []int64{2000, 2001} {
+		res = kvstore.DeliverTx(types.RequestDeliverTx{Tx: signTx(t, meter, uint64(6+i), measureTx(ts, math.MaxInt64))})
+		require.Equal(t, code.CodeTypeOK, res.Code)
+	}
+	kvstore.Commit()
+	resQuery = signedQuery("/meter/aggregate", AggregateRequest{Meter: meterID, From: 2000})
+	require.Equal(t, code.CodeTypeOK, resQuery.Code)
+	agg = AggregateResponse{}
+	require.NoError(t, json.Unmarshal(resQuery.Value, &agg))
+	require.Len(t, agg.Aggregates, 1)
+	total := new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(2))
+	assert.Equal(t, total.String(), agg.Aggregates[0].Total.String())
+	assert.Equal(t, float64(math.MaxInt64), agg.Aggregates[0].Average)
+}
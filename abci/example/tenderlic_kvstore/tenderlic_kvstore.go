@@ -28,6 +28,10 @@ type State struct {
 	Size    int64  `json:"size"`
 	Height  int64  `json:"height"`
 	AppHash []byte `json:"app_hash"`
+	// chain ID of the genesis, which transactions are signed for
+	ChainID string `json:"chain_id"`
+	// first height of the exportable history
+	RetainHeight int64 `json:"retain_height"`
 }
 
 func loadState(db dbm.DB) State {
@@ -56,7 +60,9 @@ func saveState(state State) {
 }
 
 func prefixKey(key []byte) []byte {
-	return append(kvPairPrefixKey, key...)
+	// never append in place, prefixed keys must not share memory
+	prefix := kvPairPrefixKey[:len(kvPairPrefixKey):len(kvPairPrefixKey)]
+	return append(prefix, key...)
 }
 
 var _ types.Application = (*Application)(nil)
@@ -64,9 +70,23 @@ var _ types.Application = (*Application)(nil)
 type Application struct {
 	types.BaseApplication
 	state State
+
+	// the store as of the last commit, which the queries are proven against
+	committed *storeSnapshot
+
+	// next nonce of the accounts with transactions accepted by CheckTx since
+	// the last commit
+	checkNonces map[string]uint64
+
+	// validator updates of the current block
+	valUpdates []types.ValidatorUpdate
+
+	// RetainBlocks is the number of blocks kept by tendermint after each
+	// commit (through ResponseCommit.RetainHeight), 0 keeping all of them
+	RetainBlocks int64
 }
 
 func NewApplication() *Application {
 	state := loadState(dbm.NewMemDB())
-	return &Application{state: state}
+	return &Application{state: state, committed: snapshotStore(state.db), checkNonces: make(map[string]uint64)}
 }
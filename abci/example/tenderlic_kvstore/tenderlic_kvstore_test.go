@@ -2,9 +2,12 @@ package tenderlic_kvstore
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"sort"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -16,22 +19,129 @@ import (
 	"github.com/tendermint/tendermint/abci/example/code"
 	abciserver "github.com/tendermint/tendermint/abci/server"
 	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
 )
 
 const (
-	testKey   = "abc"
-	testValue = "def"
+	testKey     = "allowed"
+	testChainID = "tenderlic-test-chain"
 )
 
+var (
+	testMeters = []crypto.PubKey{testMeter("meter1"), testMeter("meter2")}
+	testValue  = meterID("meter1") + "," + meterID("meter2")
+)
+
+// testMeter returns the public key of the test meter name.
+func testMeter(name string) crypto.PubKey {
+	return ed25519.GenPrivKeyFromSecret([]byte(name)).PubKey()
+}
+
+// meterID returns the address of the test meter name.
+func meterID(name string) string {
+	return testMeter(name).Address().String()
+}
+
+func signTx(t *testing.T, privKey crypto.PrivKey, nonce uint64, lictTx types.LictTx) []byte {
+	tx, err := MakeLictTx(privKey, testChainID, nonce, lictTx)
+	require.NoError(t, err)
+	return tx
+}
+
+func proposeTx(proposal types.LictProposal) types.LictTx {
+	return types.LictTx{Msg: &types.LictTx_Propose{Propose: &types.LictPropose{Proposal: &proposal}}}
+}
+
+func adminsTx(threshold uint32, admins ...string) types.LictTx {
+	return proposeTx(types.LictProposal{Action: &types.LictProposal_SetAdmins{
+		SetAdmins: &types.LictSetAdmins{Admins: admins, Threshold: threshold}}})
+}
+
+func allowedTx(meters ...crypto.PubKey) types.LictTx {
+	return proposeTx(types.LictProposal{Action: &types.LictProposal_SetAllowedMeters{
+		SetAllowedMeters: NewSetAllowedMeters(meters...)}})
+}
+
+func mintTx(meter string, amount uint64) types.LictTx {
+	return proposeTx(types.LictProposal{Action: &types.LictProposal_Mint{
+		Mint: &types.LictMint{Meter: meter, Amount: amount}}})
+}
+
+func approveTx(id uint64) types.LictTx {
+	return types.LictTx{Msg: &types.LictTx_Approve{Approve: &types.LictApprove{ProposalId: id}}}
+}
+
+func executeTx(id uint64) types.LictTx {
+	return types.LictTx{Msg: &types.LictTx_Execute{Execute: &types.LictExecute{ProposalId: id}}}
+}
+
+// genesisRequest returns the InitChain request of a chain governed by admins.
+func genesisRequest(t *testing.T, threshold uint32, admins ...crypto.PrivKey) types.RequestInitChain {
+	genesis := GenesisState{Threshold: threshold}
+	for _, admin := range admins {
+		genesis.Admins = append(genesis.Admins, admin.PubKey().Address().String())
+	}
+	return appStateRequest(t, genesis)
+}
+
+// appStateRequest returns the InitChain request of the genesis app_state gs,
+// for the chain testChainID.
+func appStateRequest(t *testing.T, gs GenesisState) types.RequestInitChain {
+	appState, err := gs.MarshalAppState()
+	require.NoError(t, err)
+	return types.RequestInitChain{ChainId: testChainID, AppStateBytes: appState}
+}
+
+// nextTx signs lictTx with the next nonce of the signer, counting the
+// transactions delivered since the last commit, which queries don't see.
+func nextTx(t *testing.T, app types.Application, privKey crypto.PrivKey, lictTx types.LictTx) []byte {
+	var state *Application
+	switch app := app.(type) {
+	case *Application:
+		state = app
+	case *PersistentKVStoreApplication:
+		state = app.app
+	default:
+		t.Fatalf("unexpected application %T", app)
+	}
+	return signTx(t, privKey, state.ReadNonceInDB(privKey.PubKey().Address().String()), lictTx)
+}
+
+// deliverProposal proposes lictTx and executes it with the approval of admin
+// only. The result of the execution is returned.
+func deliverProposal(t *testing.T, app types.Application, admin crypto.PrivKey, lictTx types.LictTx) types.ResponseDeliverTx {
+	res := app.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, app, admin, lictTx)})
+	require.Equal(t, code.CodeTypeOK, res.Code, res)
+	id, err := strconv.ParseUint(string(res.Data), 10, 64)
+	require.NoError(t, err)
+	return app.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, app, admin, executeTx(id))})
+}
+
+func transferTx(recipient string, amount uint64) types.LictTx {
+	return types.LictTx{Msg: &types.LictTx_Transfer{Transfer: &types.LictTransfer{Recipient: recipient, Amount: amount}}}
+}
+
+// queryBalance returns the balance of account, read by reader.
+func queryBalance(t *testing.T, app *Application, reader crypto.PrivKey, account string) string {
+	data, err := MakeSignedQuery(reader, "", []byte("lict-balance_"+account), app.state.Height)
+	require.NoError(t, err)
+	return string(app.Query(types.RequestQuery{Data: data}).Value)
+}
+
 func testKVStore(t *testing.T, app types.Application, tx []byte, key, value string) {
 	req := types.RequestDeliverTx{Tx: tx}
 	ar := app.DeliverTx(req)
 	require.False(t, ar.IsErr(), ar)
-	// repeating tx doesn't raise error
+	// repeating tx is rejected
 	ar = app.DeliverTx(req)
-	require.False(t, ar.IsErr(), ar)
+	require.Equal(t, code.CodeStaleNonce, ar.Code, ar)
 
-	// make sure query is fine
+	// make sure query is fine, once committed
+	app.Commit()
 	resQuery := app.Query(types.RequestQuery{
 		Path: "/store",
 		Data: []byte(key),
@@ -50,31 +160,267 @@ func testKVStore(t *testing.T, app types.Application, tx []byte, key, value stri
 }
 
 func TestKVStoreKV(t *testing.T) {
-	kvstore := NewApplication()
+	testGovernedKVStore(t, NewApplication())
+}
+
+func testGovernedKVStore(t *testing.T, kvstore types.Application) {
+	privKey := ed25519.GenPrivKey()
+	kvstore.InitChain(genesisRequest(t, 1, privKey))
+
 	key := testKey
-	value := key
-	tx := []byte(key)
+	value := testValue
+	res := kvstore.DeliverTx(types.RequestDeliverTx{Tx: signTx(t, privKey, 0, allowedTx(testMeters...))})
+	require.Equal(t, code.CodeTypeOK, res.Code, res)
+	tx := signTx(t, privKey, 1, executeTx(0))
 	testKVStore(t, kvstore, tx, key, value)
 
-	value = testValue
-	tx = []byte(key + "=" + value)
+	key = "governance"
+	admin := privKey.PubKey().Address().String()
+	value = fmt.Sprintf(`{"admins":["%s"],"threshold":1}`, admin)
+	res = kvstore.DeliverTx(types.RequestDeliverTx{Tx: signTx(t, privKey, 2, adminsTx(1, admin))})
+	require.Equal(t, code.CodeTypeOK, res.Code, res)
+	tx = signTx(t, privKey, 3, executeTx(1))
 	testKVStore(t, kvstore, tx, key, value)
 }
 
+func TestSignedTxSender(t *testing.T) {
+	kvstore := NewApplication()
+	admin := ed25519.GenPrivKey()
+	meter := secp256k1.GenPrivKey()
+	other := ed25519.GenPrivKey()
+	adminID := admin.PubKey().Address().String()
+	meterID := meter.PubKey().Address().String()
+
+	deliver := func(privKey crypto.PrivKey, lictTx types.LictTx) uint32 {
+		return kvstore.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, kvstore, privKey, lictTx)}).Code
+	}
+	// the queries only see the committed state
+	balance := func(id string) string {
+		kvstore.Commit()
+		return queryBalance(t, kvstore, admin, id)
+	}
+
+	kvstore.InitChain(genesisRequest(t, 1, admin))
+	require.Equal(t, code.CodeTypeOK, deliverProposal(t, kvstore, admin, allowedTx(admin.PubKey(), meter.PubKey())).Code)
+
+	// only an admin can propose to mint
+	res := kvstore.CheckTx(types.RequestCheckTx{Tx: nextTx(t, kvstore, other, mintTx(meterID, 10))})
+	require.Equal(t, code.CodeTypeUnauthorized, res.Code)
+	require.Equal(t, code.CodeTypeUnauthorized, deliver(other, mintTx(meterID, 10)))
+	res = kvstore.CheckTx(types.RequestCheckTx{Tx: nextTx(t, kvstore, admin, mintTx(meterID, 10))})
+	require.Equal(t, code.CodeTypeOK, res.Code)
+	require.Equal(t, adminID, res.Sender)
+	require.Equal(t, PriorityGovernance, res.Priority)
+	require.Equal(t, code.CodeTypeOK, deliverProposal(t, kvstore, admin, mintTx(meterID, 10)).Code)
+
+	// the sender of a transfer is the signer of the transaction
+	res = kvstore.CheckTx(types.RequestCheckTx{Tx: nextTx(t, kvstore, meter, transferTx(adminID, 4))})
+	require.Equal(t, code.CodeTypeOK, res.Code)
+	require.Equal(t, meterID, res.Sender)
+	require.Equal(t, PriorityTokens, res.Priority)
+	require.Equal(t, code.CodeTypeOK, deliver(meter, transferTx(adminID, 4)))
+	require.Equal(t, "6", balance(meterID))
+	require.Equal(t, "4", balance(adminID))
+
+	res = kvstore.CheckTx(types.RequestCheckTx{Tx: nextTx(t, kvstore, other, transferTx(adminID, 4))})
+	require.Equal(t, code.CodeExceedingAmount, res.Code)
+}
+
+func TestTokenAccounting(t *testing.T) {
+	kvstore := NewApplication()
+	admin := ed25519.GenPrivKey()
+	meter := ed25519.GenPrivKey()
+	adminID := admin.PubKey().Address().String()
+	meterID := meter.PubKey().Address().String()
+
+	deliver := func(privKey crypto.PrivKey, lictTx types.LictTx) uint32 {
+		return kvstore.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, kvstore, privKey, lictTx)}).Code
+	}
+	// the queries only see the committed state
+	balance := func(id string) string {
+		kvstore.Commit()
+		return queryBalance(t, kvstore, admin, id)
+	}
+	burnTx := func(amount uint64) types.LictTx {
+		return types.LictTx{Msg: &types.LictTx_Burn{Burn: &types.LictBurn{Amount: amount}}}
+	}
+
+	kvstore.InitChain(genesisRequest(t, 1, admin))
+	require.Equal(t, code.CodeTypeOK, deliverProposal(t, kvstore, admin, allowedTx(admin.PubKey(), meter.PubKey())).Code)
+	require.Equal(t, code.CodeTypeOK, deliverProposal(t, kvstore, admin, mintTx(meterID, 10)).Code)
+
+	// two transfers in the same block can not overdraw the balance
+	require.Equal(t, code.CodeTypeOK, deliver(meter, transferTx(adminID, 7)))
+	require.Equal(t, code.CodeExceedingAmount, deliver(meter, transferTx(adminID, 7)))
+	require.Equal(t, "3", balance(meterID))
+	require.Equal(t, "7", balance(adminID))
+
+	// transfers to the sender itself do not create tokens
+	require.Equal(t, code.CodeTypeOK, deliver(meter, transferTx(meterID, 3)))
+	require.Equal(t, "3", balance(meterID))
+
+	require.Equal(t, code.CodeExceedingAmount, deliver(meter, burnTx(4)))
+	require.Equal(t, code.CodeNotPositiveAmount, deliver(meter, burnTx(0)))
+	require.Equal(t, code.CodeTypeOK, deliver(meter, burnTx(3)))
+	require.Equal(t, "0", balance(meterID))
+
+	// balances can not overflow
+	require.Equal(t, code.CodeAmountOverflow, deliverProposal(t, kvstore, admin, mintTx(adminID, math.MaxUint64)).Code)
+	require.Equal(t, "7", balance(adminID))
+}
+
+func TestSignedTxRejected(t *testing.T) {
+	kvstore := NewApplication()
+	kvstore.InitChain(types.RequestInitChain{ChainId: testChainID})
+	privKey := ed25519.GenPrivKey()
+
+	// unsigned transactions
+	res := kvstore.CheckTx(types.RequestCheckTx{Tx: []byte(testKey + "=" + testValue)})
+	require.Equal(t, code.CodeTypeEncodingError, res.Code)
+
+	// tampered payload
+	lictTx := allowedTx(testMeters...)
+	lictTx.Version = LictTxVersion
+	payload, err := lictTx.Marshal()
+	require.NoError(t, err)
+	tx, err := NewSignedTx(privKey, testChainID, 0, payload)
+	require.NoError(t, err)
+	tx.Payload = append(tx.Payload, payload...)
+	res = kvstore.CheckTx(types.RequestCheckTx{Tx: cdc.MustMarshalBinaryBare(tx)})
+	require.Equal(t, code.CodeTypeEncodingError, res.Code)
+	ar := kvstore.DeliverTx(types.RequestDeliverTx{Tx: cdc.MustMarshalBinaryBare(tx)})
+	require.Equal(t, code.CodeTypeEncodingError, ar.Code)
+
+	// transactions signed for another chain
+	tx, err = NewSignedTx(privKey, "other-chain", 0, payload)
+	require.NoError(t, err)
+	require.Error(t, tx.ValidateBasic(testChainID))
+	res = kvstore.CheckTx(types.RequestCheckTx{Tx: cdc.MustMarshalBinaryBare(tx)})
+	require.Equal(t, code.CodeTypeEncodingError, res.Code)
+	ar = kvstore.DeliverTx(types.RequestDeliverTx{Tx: cdc.MustMarshalBinaryBare(tx)})
+	require.Equal(t, code.CodeTypeEncodingError, ar.Code)
+
+	// unsupported key type
+	tx, err = NewSignedTx(sr25519.GenPrivKey(), testChainID, 0, payload)
+	require.NoError(t, err)
+	res = kvstore.CheckTx(types.RequestCheckTx{Tx: cdc.MustMarshalBinaryBare(tx)})
+	require.Equal(t, code.CodeTypeEncodingError, res.Code)
+
+	// malformed payloads are rejected without consuming the nonce
+	for _, payload := range [][]byte{[]byte(testKey + "=" + testValue), []byte("meter_1_2_3=4"), {0xff}} {
+		txBytes, err := MakeSignedTx(privKey, testChainID, 0, payload)
+		require.NoError(t, err)
+		res = kvstore.CheckTx(types.RequestCheckTx{Tx: txBytes})
+		require.Equal(t, code.CodeTypeEncodingError, res.Code)
+	}
+
+	// unsupported versions
+	lictTx.Version = LictTxVersion + 1
+	payload, err = lictTx.Marshal()
+	require.NoError(t, err)
+	txBytes, err := MakeSignedTx(privKey, testChainID, 0, payload)
+	require.NoError(t, err)
+	res = kvstore.CheckTx(types.RequestCheckTx{Tx: txBytes})
+	require.Equal(t, code.CodeTypeEncodingError, res.Code)
+	res = kvstore.CheckTx(types.RequestCheckTx{Tx: signTx(t, privKey, 0, measureTx(1, 1))})
+	require.Equal(t, code.CodeTypeOK, res.Code)
+}
+
+func TestNonces(t *testing.T) {
+	kvstore := NewApplication()
+	kvstore.InitChain(types.RequestInitChain{ChainId: testChainID})
+	privKey := ed25519.GenPrivKey()
+	account := privKey.PubKey().Address().String()
+
+	checkTx := func(nonce uint64) uint32 {
+		return kvstore.CheckTx(types.RequestCheckTx{Tx: signTx(t, privKey, nonce, measureTx(int64(nonce), 1))}).Code
+	}
+	deliverTx := func(nonce uint64) uint32 {
+		return kvstore.DeliverTx(types.RequestDeliverTx{Tx: signTx(t, privKey, nonce, measureTx(int64(nonce), 1))}).Code
+	}
+	nextNonce := func() string {
+		return string(kvstore.Query(types.RequestQuery{Path: "/nonce", Data: []byte(account)}).Value)
+	}
+
+	require.Equal(t, "0", nextNonce())
+	require.Equal(t, code.CodeFutureNonce, checkTx(1))
+	// consecutive transactions are accepted by the mempool before a commit
+	require.Equal(t, code.CodeTypeOK, checkTx(0))
+	require.Equal(t, code.CodeTypeOK, checkTx(1))
+	require.Equal(t, code.CodeStaleNonce, checkTx(1))
+
+	require.Equal(t, code.CodeFutureNonce, deliverTx(1))
+	require.Equal(t, code.CodeTypeOK, deliverTx(0))
+	require.Equal(t, code.CodeStaleNonce, deliverTx(0))
+	// the queries only see the committed nonce
+	require.Equal(t, "0", nextNonce())
+	kvstore.Commit()
+	require.Equal(t, "1", nextNonce())
+
+	// recheck after the commit
+	require.Equal(t, code.CodeStaleNonce, checkTx(0))
+	require.Equal(t, code.CodeTypeOK, checkTx(1))
+}
+
+func TestAppHashAndProof(t *testing.T) {
+	kvstore1, kvstore2 := NewApplication(), NewApplication()
+	privKey := ed25519.GenPrivKey()
+
+	kvstore1.InitChain(genesisRequest(t, 1, privKey))
+	kvstore2.InitChain(genesisRequest(t, 1, privKey))
+	deliverProposal(t, kvstore1, privKey, allowedTx(testMeters...))
+	deliverProposal(t, kvstore2, privKey, allowedTx(testMeter("meter3")))
+	res1, res2 := kvstore1.Commit(), kvstore2.Commit()
+	require.NotEmpty(t, res1.Data)
+	require.NotEqual(t, res1.Data, res2.Data, "different states must have different app hashes")
+
+	resQuery := kvstore1.Query(types.RequestQuery{
+		Path:  "/store/" + StoreName + "/key",
+		Data:  []byte(testKey),
+		Prove: true,
+	})
+	require.Equal(t, testValue, string(resQuery.Value))
+	require.EqualValues(t, 1, resQuery.Height)
+	require.NotNil(t, resQuery.Proof)
+
+	prt := merkle.DefaultProofRuntime()
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(StoreName), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(resQuery.Key, merkle.KeyEncodingURL)
+	require.NoError(t, prt.VerifyValue(resQuery.Proof, res1.Data, kp.String(), resQuery.Value))
+	require.Error(t, prt.VerifyValue(resQuery.Proof, res2.Data, kp.String(), resQuery.Value))
+	require.Error(t, prt.VerifyValue(resQuery.Proof, res1.Data, kp.String(), []byte("meter3")))
+
+	// until the next commit, the committed value is proven
+	deliverProposal(t, kvstore1, privKey, allowedTx(testMeter("meter3")))
+	resQuery = kvstore1.Query(types.RequestQuery{
+		Path:  "/store/" + StoreName + "/key",
+		Data:  []byte(testKey),
+		Prove: true,
+	})
+	require.Equal(t, testValue, string(resQuery.Value))
+	require.EqualValues(t, 1, resQuery.Height)
+	require.NoError(t, prt.VerifyValue(resQuery.Proof, res1.Data, kp.String(), resQuery.Value))
+}
+
+func TestRetainBlocks(t *testing.T) {
+	kvstore := NewApplication()
+
+	// all the blocks are kept by default
+	require.Zero(t, kvstore.Commit().RetainHeight)
+
+	kvstore.RetainBlocks = 3
+	require.Zero(t, kvstore.Commit().RetainHeight)
+	require.EqualValues(t, 1, kvstore.Commit().RetainHeight)
+	require.EqualValues(t, 2, kvstore.Commit().RetainHeight)
+}
+
 func TestPersistentKVStoreKV(t *testing.T) {
 	dir, err := ioutil.TempDir("/tmp", "abci-kvstore-test") // TODO
 	if err != nil {
 		t.Fatal(err)
 	}
-	kvstore := NewPersistentKVStoreApplication(dir)
-	key := testKey
-	value := key
-	tx := []byte(key)
-	testKVStore(t, kvstore, tx, key, value)
-
-	value = testValue
-	tx = []byte(key + "=" + value)
-	testKVStore(t, kvstore, tx, key, value)
+	testGovernedKVStore(t, NewPersistentKVStoreApplication(dir))
 }
 
 func TestPersistentKVStoreInfo(t *testing.T) {
@@ -108,6 +454,19 @@ func TestPersistentKVStoreInfo(t *testing.T) {
 
 }
 
+func setValidatorTx(v types.ValidatorUpdate) types.LictTx {
+	return proposeTx(types.LictProposal{Action: &types.LictProposal_SetValidator{
+		SetValidator: &types.LictSetValidator{PubKey: v.PubKey.Data, Power: v.Power}}})
+}
+
+func validatorUpdates(vals []Validator) []types.ValidatorUpdate {
+	updates := make([]types.ValidatorUpdate, len(vals))
+	for i, v := range vals {
+		updates[i] = types.Ed25519ValidatorUpdate(v.PubKey, v.Power)
+	}
+	return updates
+}
+
 // add a validator, remove a validator, update a validator
 func TestValUpdates(t *testing.T) {
 	dir, err := ioutil.TempDir("/tmp", "abci-kvstore-test") // TODO
@@ -115,17 +474,18 @@ func TestValUpdates(t *testing.T) {
 		t.Fatal(err)
 	}
 	kvstore := NewPersistentKVStoreApplication(dir)
+	admin := ed25519.GenPrivKey()
 
 	// init with some validators
 	total := 10
 	nInit := 5
 	vals := RandVals(total)
 	// iniitalize with the first nInit
-	kvstore.InitChain(types.RequestInitChain{
-		Validators: vals[:nInit],
-	})
+	req := genesisRequest(t, 1, admin)
+	req.Validators = vals[:nInit]
+	kvstore.InitChain(req)
 
-	vals1, vals2 := vals[:nInit], kvstore.Validators()
+	vals1, vals2 := vals[:nInit], validatorUpdates(kvstore.Validators())
 	valsEqual(t, vals1, vals2)
 
 	var v1, v2, v3 types.ValidatorUpdate
@@ -133,12 +493,10 @@ func TestValUpdates(t *testing.T) {
 	// add some validators
 	v1, v2 = vals[nInit], vals[nInit+1]
 	diff := []types.ValidatorUpdate{v1, v2}
-	tx1 := MakeValSetChangeTx(v1.PubKey, v1.Power)
-	tx2 := MakeValSetChangeTx(v2.PubKey, v2.Power)
 
-	makeApplyBlock(t, kvstore, 1, diff, tx1, tx2)
+	makeApplyBlock(t, kvstore, 1, diff, admin, v1, v2)
 
-	vals1, vals2 = vals[:nInit+2], kvstore.Validators()
+	vals1, vals2 = vals[:nInit+2], validatorUpdates(kvstore.Validators())
 	valsEqual(t, vals1, vals2)
 
 	// remove some validators
@@ -147,32 +505,87 @@ func TestValUpdates(t *testing.T) {
 	v2.Power = 0
 	v3.Power = 0
 	diff = []types.ValidatorUpdate{v1, v2, v3}
-	tx1 = MakeValSetChangeTx(v1.PubKey, v1.Power)
-	tx2 = MakeValSetChangeTx(v2.PubKey, v2.Power)
-	tx3 := MakeValSetChangeTx(v3.PubKey, v3.Power)
 
-	makeApplyBlock(t, kvstore, 2, diff, tx1, tx2, tx3)
+	makeApplyBlock(t, kvstore, 2, diff, admin, v1, v2, v3)
 
 	vals1 = append(vals[:nInit-2], vals[nInit+1]) // nolint: gocritic
-	vals2 = kvstore.Validators()
+	vals2 = validatorUpdates(kvstore.Validators())
 	valsEqual(t, vals1, vals2)
 
-	// update some validators
+	// update some validators, the last update of a block wins
 	v1 = vals[0]
-	if v1.Power == 5 {
-		v1.Power = 6
+	v1.Power = 1
+	v2 = vals[0]
+	if v2.Power == 5 {
+		v2.Power = 6
 	} else {
-		v1.Power = 5
+		v2.Power = 5
 	}
-	diff = []types.ValidatorUpdate{v1}
-	tx1 = MakeValSetChangeTx(v1.PubKey, v1.Power)
+	diff = []types.ValidatorUpdate{v2}
 
-	makeApplyBlock(t, kvstore, 3, diff, tx1)
+	makeApplyBlock(t, kvstore, 3, diff, admin, v1, v2)
 
-	vals1 = append([]types.ValidatorUpdate{v1}, vals1[1:]...)
-	vals2 = kvstore.Validators()
+	vals1 = append([]types.ValidatorUpdate{v2}, vals1[1:]...)
+	vals2 = validatorUpdates(kvstore.Validators())
 	valsEqual(t, vals1, vals2)
 
+	// the validator set is queryable
+	resQuery := kvstore.Query(types.RequestQuery{Path: "/validators"})
+	var queried []Validator
+	require.NoError(t, json.Unmarshal(resQuery.Value, &queried))
+	valsEqual(t, vals1, validatorUpdates(queried))
+}
+
+func TestValUpdatesAuthorization(t *testing.T) {
+	kvstore := NewApplication()
+	admin := ed25519.GenPrivKey()
+	other := ed25519.GenPrivKey()
+	vals := RandVals(3)
+
+	req := appStateRequest(t, GenesisState{
+		Admins:            []string{admin.PubKey().Address().String()},
+		Threshold:         1,
+		MaxValidatorPower: 200,
+	})
+	req.Validators = vals[:1]
+	kvstore.InitChain(req)
+	require.EqualValues(t, 200, kvstore.MaxValidatorPower())
+
+	deliver := func(privKey crypto.PrivKey, lictTx types.LictTx) uint32 {
+		return kvstore.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, kvstore, privKey, lictTx)}).Code
+	}
+	kvstore.BeginBlock(types.RequestBeginBlock{})
+
+	// only the admins can propose validators
+	require.Equal(t, code.CodeTypeUnauthorized, deliver(other, setValidatorTx(vals[1])))
+
+	// power limits
+	v := vals[1]
+	v.Power = 201
+	require.Equal(t, code.CodeExceedingPower, deliver(admin, setValidatorTx(v)))
+	v.Power = -1
+	require.Equal(t, code.CodeTypeBadRequest, deliver(admin, setValidatorTx(v)))
+
+	// unknown and last validators can not be removed
+	v.Power = 0
+	require.Equal(t, code.CodeTypeBadRequest, deliver(admin, setValidatorTx(v)))
+	v = vals[0]
+	v.Power = 0
+	require.Equal(t, code.CodeTypeBadRequest, deliver(admin, setValidatorTx(v)))
+
+	// invalid keys
+	v = types.ValidatorUpdate{PubKey: types.PubKey{Type: types.PubKeyEd25519, Data: []byte("short")}, Power: 1}
+	require.Equal(t, code.CodeTypeEncodingError, deliver(admin, setValidatorTx(v)))
+
+	// an approved proposal is required
+	require.Equal(t, code.CodeTypeOK, deliverProposal(t, kvstore, admin, setValidatorTx(vals[2])).Code)
+	require.Len(t, kvstore.EndBlock(types.RequestEndBlock{}).ValidatorUpdates, 1)
+	valsEqual(t, []types.ValidatorUpdate{vals[0], vals[2]}, validatorUpdates(kvstore.Validators()))
+	kvstore.Commit()
+
+	// the updates are reset at every block
+	kvstore.BeginBlock(types.RequestBeginBlock{})
+	require.Empty(t, kvstore.EndBlock(types.RequestEndBlock{}).ValidatorUpdates)
 }
 
 func makeApplyBlock(
@@ -180,7 +593,8 @@ func makeApplyBlock(
 	kvstore types.Application,
 	heightInt int,
 	diff []types.ValidatorUpdate,
-	txs ...[]byte) {
+	admin crypto.PrivKey,
+	updates ...types.ValidatorUpdate) {
 	// make and apply block
 	height := int64(heightInt)
 	hash := []byte("foo")
@@ -189,8 +603,8 @@ func makeApplyBlock(
 	}
 
 	kvstore.BeginBlock(types.RequestBeginBlock{Hash: hash, Header: header})
-	for _, tx := range txs {
-		if r := kvstore.DeliverTx(types.RequestDeliverTx{Tx: tx}); r.IsErr() {
+	for _, v := range updates {
+		if r := deliverProposal(t, kvstore, admin, setValidatorTx(v)); r.IsErr() {
 			t.Fatal(r)
 		}
 	}
@@ -282,26 +696,38 @@ func TestClientServer(t *testing.T) {
 
 func runClientTests(t *testing.T, client abcicli.Client) {
 	// run some tests....
+	privKey := secp256k1.GenPrivKey()
+	_, err := client.InitChainSync(genesisRequest(t, 1, privKey))
+	require.NoError(t, err)
+
 	key := testKey
-	value := key
-	tx := []byte(key)
+	value := testValue
+	res, err := client.DeliverTxSync(types.RequestDeliverTx{Tx: signTx(t, privKey, 0, allowedTx(testMeters...))})
+	require.NoError(t, err)
+	require.Equal(t, code.CodeTypeOK, res.Code, res)
+	tx := signTx(t, privKey, 1, executeTx(0))
 	testClient(t, client, tx, key, value)
 
-	value = testValue
-	tx = []byte(key + "=" + value)
-	testClient(t, client, tx, key, value)
+	key = "proposal_0"
+	resQuery, err := client.QuerySync(types.RequestQuery{Path: "/store", Data: []byte(key)})
+	require.NoError(t, err)
+	var p Proposal
+	require.NoError(t, json.Unmarshal(resQuery.Value, &p))
+	require.True(t, p.Executed)
 }
 
 func testClient(t *testing.T, app abcicli.Client, tx []byte, key, value string) {
 	ar, err := app.DeliverTxSync(types.RequestDeliverTx{Tx: tx})
 	require.NoError(t, err)
 	require.False(t, ar.IsErr(), ar)
-	// repeating tx doesn't raise error
+	// repeating tx is rejected
 	ar, err = app.DeliverTxSync(types.RequestDeliverTx{Tx: tx})
 	require.NoError(t, err)
-	require.False(t, ar.IsErr(), ar)
+	require.Equal(t, code.CodeStaleNonce, ar.Code, ar)
 
-	// make sure query is fine
+	// make sure query is fine, once committed
+	_, err = app.CommitSync()
+	require.NoError(t, err)
 	resQuery, err := app.QuerySync(types.RequestQuery{
 		Path: "/store",
 		Data: []byte(key),
@@ -320,3 +746,77 @@ func testClient(t *testing.T, app abcicli.Client, tx []byte, key, value string)
 	require.Equal(t, code.CodeTypeOK, resQuery.Code)
 	require.Equal(t, value, string(resQuery.Value))
 }
+
+func TestGovernance(t *testing.T) {
+	kvstore := NewApplication()
+	adminA, adminB, adminC := ed25519.GenPrivKey(), secp256k1.GenPrivKey(), ed25519.GenPrivKey()
+	other := ed25519.GenPrivKey()
+	idA := adminA.PubKey().Address().String()
+	idB := adminB.PubKey().Address().String()
+	idC := adminC.PubKey().Address().String()
+
+	deliver := func(privKey crypto.PrivKey, lictTx types.LictTx) types.ResponseDeliverTx {
+		return kvstore.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, kvstore, privKey, lictTx)})
+	}
+	check := func(privKey crypto.PrivKey, lictTx types.LictTx) uint32 {
+		return kvstore.CheckTx(types.RequestCheckTx{Tx: nextTx(t, kvstore, privKey, lictTx)}).Code
+	}
+	// the queries only see the committed state
+	allowed := func() string {
+		kvstore.Commit()
+		return string(kvstore.Query(types.RequestQuery{Data: []byte("allowed")}).Value)
+	}
+
+	// without genesis admins nobody can take control of the chain
+	ungoverned := NewApplication()
+	ungoverned.InitChain(types.RequestInitChain{ChainId: testChainID})
+	res := ungoverned.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, ungoverned, other, allowedTx(testMeter("meter1")))})
+	require.Equal(t, code.CodeTypeUnauthorized, res.Code)
+	res = ungoverned.DeliverTx(types.RequestDeliverTx{Tx: nextTx(t, ungoverned, other, adminsTx(1, idA))})
+	require.Equal(t, code.CodeTypeUnauthorized, res.Code)
+
+	kvstore.InitChain(genesisRequest(t, 2, adminA, adminB, adminC))
+	require.Equal(t, code.CodeTypeUnauthorized, check(other, allowedTx(testMeter("meter1"))))
+	require.Equal(t, code.CodeTypeBadRequest, check(adminA, adminsTx(3, idA, idB)))
+	require.Equal(t, code.CodeTypeBadRequest, check(adminA, adminsTx(1, idA, idA)))
+
+	// 2-of-3 approvals
+	res = deliver(adminA, allowedTx(testMeter("meter1")))
+	require.Equal(t, code.CodeTypeOK, res.Code)
+	require.Equal(t, "0", string(res.Data))
+	require.Equal(t, code.CodeTypeUnauthorized, deliver(adminC, executeTx(0)).Code)
+	require.Equal(t, code.CodeTypeUnauthorized, deliver(other, approveTx(0)).Code)
+	require.Equal(t, code.CodeTypeBadRequest, deliver(adminA, approveTx(0)).Code)
+	require.Equal(t, code.CodeTypeBadRequest, deliver(adminB, approveTx(1)).Code)
+	require.Equal(t, "", allowed())
+
+	require.Equal(t, code.CodeTypeOK, check(adminB, approveTx(0)))
+	require.Equal(t, code.CodeTypeOK, deliver(adminB, approveTx(0)).Code)
+	require.Equal(t, code.CodeTypeUnauthorized, deliver(other, executeTx(0)).Code)
+	require.Equal(t, code.CodeTypeOK, check(adminC, executeTx(0)))
+	require.Equal(t, code.CodeTypeOK, deliver(adminC, executeTx(0)).Code)
+	require.Equal(t, meterID("meter1"), allowed())
+	require.Equal(t, code.CodeTypeBadRequest, deliver(adminC, executeTx(0)).Code)
+	require.Equal(t, code.CodeTypeBadRequest, deliver(adminC, approveTx(0)).Code)
+
+	// a pending proposal approved by A and B
+	require.Equal(t, code.CodeTypeOK, deliver(adminA, allowedTx(testMeter("meter2"))).Code)
+	require.Equal(t, code.CodeTypeOK, deliver(adminB, approveTx(1)).Code)
+
+	// rotate the admins: A is removed and its approvals no longer count
+	require.Equal(t, code.CodeTypeOK, deliver(adminB, adminsTx(2, idB, idC)).Code)
+	require.Equal(t, code.CodeTypeOK, deliver(adminC, approveTx(2)).Code)
+	require.Equal(t, code.CodeTypeOK, deliver(adminB, executeTx(2)).Code)
+
+	require.Equal(t, code.CodeTypeUnauthorized, deliver(adminA, allowedTx(testMeter("meter3"))).Code)
+	require.Equal(t, code.CodeTypeUnauthorized, deliver(adminB, executeTx(1)).Code)
+	require.Equal(t, code.CodeTypeOK, deliver(adminC, approveTx(1)).Code)
+	require.Equal(t, code.CodeTypeOK, deliver(adminB, executeTx(1)).Code)
+	require.Equal(t, meterID("meter2"), allowed())
+
+	kvstore.Commit()
+	resQuery := kvstore.Query(types.RequestQuery{Data: []byte("governance")})
+	var g Governance
+	require.NoError(t, json.Unmarshal(resQuery.Value, &g))
+	require.Equal(t, Governance{Admins: []string{idB, idC}, Threshold: 2}, g)
+}
@@ -0,0 +1,149 @@
+package tenderlic_kvstore
+
+import (
+	"errors"
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// LictTxVersion is the version of the transactions message set.
+const LictTxVersion uint32 = 3
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cryptoamino.RegisterAmino(cdc)
+}
+
+// SignedTx is the envelope of every TenderLIC transaction. The sender is the
+// owner of PubKey, so the identity of a transaction does not depend on the
+// node executing it.
+type SignedTx struct {
+	Payload   []byte        `json:"payload"`
+	PubKey    crypto.PubKey `json:"pub_key"`
+	Nonce     uint64        `json:"nonce"`
+	Signature []byte        `json:"signature"`
+}
+
+// signDoc is the document signed by the sender of a transaction. The chain ID
+// is not part of the envelope, but binds the signature to a single network.
+type signDoc struct {
+	ChainID string        `json:"chain_id"`
+	Payload []byte        `json:"payload"`
+	PubKey  crypto.PubKey `json:"pub_key"`
+	Nonce   uint64        `json:"nonce"`
+}
+
+// SignBytes returns the bytes covered by the signature: the chain ID and the
+// whole envelope without the signature itself.
+func (tx SignedTx) SignBytes(chainID string) []byte {
+	return cdc.MustMarshalBinaryBare(signDoc{
+		ChainID: chainID,
+		Payload: tx.Payload,
+		PubKey:  tx.PubKey,
+		Nonce:   tx.Nonce,
+	})
+}
+
+// Sender returns the identifier of the account which signed the transaction.
+func (tx SignedTx) Sender() string {
+	return tx.PubKey.Address().String()
+}
+
+// ValidateBasic checks the key type and the signature of the envelope, signed
+// for the chain chainID.
+func (tx SignedTx) ValidateBasic(chainID string) error {
+	if len(tx.Payload) == 0 {
+		return errors.New("empty payload")
+	}
+	if err := checkPubKey(tx.PubKey); err != nil {
+		return err
+	}
+	if !tx.PubKey.VerifyBytes(tx.SignBytes(chainID), tx.Signature) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// checkPubKey returns an error if pubKey can not identify an account.
+func checkPubKey(pubKey crypto.PubKey) error {
+	switch pubKey.(type) {
+	case ed25519.PubKeyEd25519, secp256k1.PubKeySecp256k1:
+		return nil
+	case nil:
+		return errors.New("missing public key")
+	default:
+		return fmt.Errorf("unsupported public key type %T", pubKey)
+	}
+}
+
+// NewSignedTx wraps payload into an envelope signed by privKey for the chain
+// chainID.
+func NewSignedTx(privKey crypto.PrivKey, chainID string, nonce uint64, payload []byte) (SignedTx, error) {
+	tx := SignedTx{
+		Payload: payload,
+		PubKey:  privKey.PubKey(),
+		Nonce:   nonce,
+	}
+	sig, err := privKey.Sign(tx.SignBytes(chainID))
+	if err != nil {
+		return SignedTx{}, err
+	}
+	tx.Signature = sig
+	return tx, nil
+}
+
+// MakeSignedTx returns the encoded envelope of payload signed by privKey for the
+// chain chainID, ready to be broadcast.
+func MakeSignedTx(privKey crypto.PrivKey, chainID string, nonce uint64, payload []byte) ([]byte, error) {
+	tx, err := NewSignedTx(privKey, chainID, nonce, payload)
+	if err != nil {
+		return nil, err
+	}
+	return cdc.MarshalBinaryBare(tx)
+}
+
+// DecodeTx decodes and verifies a transaction signed for the chain chainID.
+func DecodeTx(txBytes []byte, chainID string) (SignedTx, error) {
+	var tx SignedTx
+	if err := cdc.UnmarshalBinaryBare(txBytes, &tx); err != nil {
+		return SignedTx{}, err
+	}
+	if err := tx.ValidateBasic(chainID); err != nil {
+		return SignedTx{}, err
+	}
+	return tx, nil
+}
+
+// MakeLictTx returns the encoded envelope of lictTx signed by privKey for the
+// chain chainID.
+func MakeLictTx(privKey crypto.PrivKey, chainID string, nonce uint64, lictTx types.LictTx) ([]byte, error) {
+	lictTx.Version = LictTxVersion
+	payload, err := lictTx.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return MakeSignedTx(privKey, chainID, nonce, payload)
+}
+
+// DecodeLictTx decodes the payload of a signed transaction.
+func DecodeLictTx(payload []byte) (types.LictTx, error) {
+	var lictTx types.LictTx
+	if err := lictTx.Unmarshal(payload); err != nil {
+		return types.LictTx{}, err
+	}
+	if lictTx.Version != LictTxVersion {
+		return types.LictTx{}, fmt.Errorf("unsupported transaction version %d", lictTx.Version)
+	}
+	if lictTx.Msg == nil {
+		return types.LictTx{}, errors.New("empty transaction")
+	}
+	return lictTx, nil
+}
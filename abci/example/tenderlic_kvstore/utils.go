@@ -1,24 +1,12 @@
 package tenderlic_kvstore
 
 import (
-	"crypto/sha512"
-	"encoding/binary"
 	"fmt"
 	"github.com/op/go-logging"
 	"github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/version"
-	"net"
 )
 
-// Logger variables
-
-func (app *Application) CalcSHA512Hash(input string) string {
-	h := sha512.New()
-	h.Write([]byte(input))
-	macHash := h.Sum(nil)
-	return fmt.Sprintf("%x", macHash)
-}
-
 func (app *Application) SetLogger() {
 	backend1Leveled.SetLevel(logging.CRITICAL, "")
 	logging.SetBackend(backend1Leveled, backend2Formatter)
@@ -33,21 +21,6 @@ func (app *Application) Contains(arr []string, str string) bool {
 	return false
 }
 
-func (app *Application) GetMacAddr() ([]string, error) {
-	netInts, err := net.Interfaces()
-	if err != nil {
-		return nil, err
-	}
-	var macs []string
-	for _, netInt := range netInts {
-		mac := netInt.HardwareAddr.String()
-		if mac != "" {
-			macs = append(macs, mac)
-		}
-	}
-	return macs, nil
-}
-
 func (app *Application) GetAllowedMeters() []byte {
 	keyFlag := []byte("allowed")
 	allowedMeters, err := app.state.db.Get(prefixKey(keyFlag))
@@ -68,11 +41,22 @@ func (app *Application) Info(req types.RequestInfo) (resInfo types.ResponseInfo)
 }
 
 func (app *Application) Commit() types.ResponseCommit {
-	// Using a memdb - just return the big endian size of the db
-	appHash := make([]byte, 8)
-	binary.PutVarint(appHash, app.state.Size)
+	// The app hash authenticates every stored key-value pair
+	app.committed = snapshotStore(app.state.db)
+	appHash := app.committed.appHash
 	app.state.AppHash = appHash
 	app.state.Height++
+
+	// The history of the readings and balances is kept as long as the blocks
+	resp := types.ResponseCommit{Data: appHash}
+	if app.RetainBlocks > 0 && app.state.Height >= app.RetainBlocks {
+		resp.RetainHeight = app.state.Height - app.RetainBlocks + 1
+		app.pruneHistory(resp.RetainHeight)
+	}
 	saveState(app.state)
-	return types.ResponseCommit{Data: appHash}
+
+	// The mempool rechecks the remaining transactions against the new state
+	app.checkNonces = make(map[string]uint64)
+
+	return resp
 }
@@ -0,0 +1,185 @@
+package tenderlic_kvstore
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/tendermint/abci/example/code"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+const (
+	// DefaultMaxValidatorPower is the maximum voting power of a validator when
+	// the genesis app_state does not set one.
+	DefaultMaxValidatorPower int64 = 100
+)
+
+var maxValidatorPowerKey = []byte("max-validator-power")
+
+// Validator is a member of the validator set stored on chain.
+type Validator struct {
+	PubKey []byte `json:"pub_key"` // ed25519
+	Power  int64  `json:"power"`
+}
+
+// validatorKey returns the key of the validator with the given ed25519 public
+// key. The validators are stored in the order of their keys.
+func validatorKey(pubKey []byte) []byte {
+	return []byte(fmt.Sprintf("validator_%X", pubKey))
+}
+
+// MaxValidatorPower returns the maximum voting power of a validator.
+func (app *Application) MaxValidatorPower() int64 {
+	bz, err := app.state.db.Get(prefixKey(maxValidatorPowerKey))
+	if err != nil {
+		panic(err)
+	}
+	if power, err := strconv.ParseInt(string(bz), 10, 64); err == nil {
+		return power
+	}
+	return DefaultMaxValidatorPower
+}
+
+// LoadValidator returns the validator with the given public key, if any.
+func (app *Application) LoadValidator(pubKey []byte) (Validator, bool) {
+	var v Validator
+	bz, err := app.state.db.Get(prefixKey(validatorKey(pubKey)))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return v, false
+	}
+	if err := json.Unmarshal(bz, &v); err != nil {
+		panic(err)
+	}
+	return v, true
+}
+
+// Validators returns the current validator set, ordered by public key.
+func (app *Application) Validators() []Validator {
+	start := prefixKey([]byte("validator_"))
+	end := prefixKey([]byte("validator`")) // '`' follows '_'
+	itr, err := app.state.db.Iterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	validators := []Validator{}
+	for ; itr.Valid(); itr.Next() {
+		var v Validator
+		if err := json.Unmarshal(itr.Value(), &v); err != nil {
+			panic(err)
+		}
+		validators = append(validators, v)
+	}
+	return validators
+}
+
+// GetValidators returns the JSON validator set for the /validators path.
+func (app *Application) GetValidators(reqQuery types.RequestQuery) (resQuery types.ResponseQuery) {
+	resQuery.Value, _ = json.Marshal(app.Validators())
+	resQuery.Log = "Validator set"
+	resQuery.Height = app.state.Height
+	return resQuery
+}
+
+// validateSetValidator checks a validator update against the current set.
+func (app *Application) validateSetValidator(msg *types.LictSetValidator) (uint32, string) {
+	if len(msg.PubKey) != ed25519.PubKeyEd25519Size {
+		return code.CodeTypeEncodingError, fmt.Sprintf("Expected an ed25519 public key of %d bytes", ed25519.PubKeyEd25519Size)
+	}
+	if msg.Power < 0 {
+		return code.CodeTypeBadRequest, "The power must not be negative"
+	}
+	if max := app.MaxValidatorPower(); msg.Power > max {
+		return code.CodeExceedingPower, fmt.Sprintf("The power %d exceeds the maximum of %d", msg.Power, max)
+	}
+	if msg.Power == 0 {
+		if _, ok := app.LoadValidator(msg.PubKey); !ok {
+			return code.CodeTypeBadRequest, fmt.Sprintf("Cannot remove non-existent validator %X", msg.PubKey)
+		}
+		if len(app.Validators()) == 1 {
+			return code.CodeTypeBadRequest, "Cannot remove the last validator"
+		}
+	}
+	return code.CodeTypeOK, ""
+}
+
+// setValidator adds, updates or removes (power 0) a validator and queues the
+// update for EndBlock.
+func (app *Application) setValidator(v Validator) types.ResponseDeliverTx {
+	key := validatorKey(v.PubKey)
+	if v.Power == 0 {
+		app.state.db.Delete(prefixKey(key))
+	} else {
+		bz, err := json.Marshal(v)
+		if err != nil {
+			panic(err)
+		}
+		app.SetKVOnDB(key, bz)
+	}
+	app.queueValidatorUpdate(types.Ed25519ValidatorUpdate(v.PubKey, v.Power))
+
+	return types.ResponseDeliverTx{
+		Code: code.CodeTypeOK,
+		Events: []types.Event{newEvent(EventTypeValidator,
+			"pub_key", strings.ToUpper(hex.EncodeToString(v.PubKey)),
+			"power", strconv.FormatInt(v.Power, 10))},
+	}
+}
+
+// queueValidatorUpdate adds update to the updates of the block, replacing a
+// previous update of the same validator.
+func (app *Application) queueValidatorUpdate(update types.ValidatorUpdate) {
+	for i, u := range app.valUpdates {
+		if u.PubKey.Equal(update.PubKey) {
+			app.valUpdates[i] = update
+			return
+		}
+	}
+	app.valUpdates = append(app.valUpdates, update)
+}
+
+// DeliverTxSetValidator applies the validator update of an executed proposal.
+func (app *Application) DeliverTxSetValidator(msg *types.LictSetValidator) types.ResponseDeliverTx {
+	if c, log := app.validateSetValidator(msg); c != code.CodeTypeOK {
+		return types.ResponseDeliverTx{Code: c, Log: log}
+	}
+	return app.setValidator(Validator{PubKey: msg.PubKey, Power: msg.Power})
+}
+
+// initValidators stores the genesis validators.
+func (app *Application) initValidators(validators []types.ValidatorUpdate) {
+	max := app.MaxValidatorPower()
+	for _, v := range validators {
+		if v.PubKey.Type != types.PubKeyEd25519 || len(v.PubKey.Data) != ed25519.PubKeyEd25519Size {
+			panic(fmt.Sprintf("invalid genesis validator %v: expected an ed25519 key", v))
+		}
+		if v.Power <= 0 || v.Power > max {
+			panic(fmt.Sprintf("invalid genesis validator %v: power not in [1, %d]", v, max))
+		}
+		bz, err := json.Marshal(Validator{PubKey: v.PubKey.Data, Power: v.Power})
+		if err != nil {
+			panic(err)
+		}
+		app.SetKVOnDB(validatorKey(v.PubKey.Data), bz)
+	}
+}
+
+// BeginBlock resets the validator updates of the block.
+func (app *Application) BeginBlock(req types.RequestBeginBlock) types.ResponseBeginBlock {
+	app.valUpdates = make([]types.ValidatorUpdate, 0)
+	return types.ResponseBeginBlock{}
+}
+
+// EndBlock returns the validator updates of the proposals executed in the
+// block.
+func (app *Application) EndBlock(req types.RequestEndBlock) types.ResponseEndBlock {
+	return types.ResponseEndBlock{ValidatorUpdates: app.valUpdates}
+}
@@ -0,0 +1,4651 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: abci/types/tenderlic.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	golang_proto "github.com/golang/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = golang_proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type LictTx struct {
+	// Version of the message set, see LictTxVersion
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Types that are valid to be assigned to Msg:
+	//	*LictTx_Transfer
+	//	*LictTx_Burn
+	//	*LictTx_PowerMeasure
+	//	*LictTx_Propose
+	//	*LictTx_Approve
+	//	*LictTx_Execute
+	Msg                  isLictTx_Msg `protobuf_oneof:"msg"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *LictTx) Reset()         { *m = LictTx{} }
+func (m *LictTx) String() string { return proto.CompactTextString(m) }
+func (*LictTx) ProtoMessage()    {}
+func (*LictTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{0}
+}
+func (m *LictTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictTx.Merge(m, src)
+}
+func (m *LictTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictTx proto.InternalMessageInfo
+
+type isLictTx_Msg interface {
+	isLictTx_Msg()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type LictTx_Transfer struct {
+	Transfer *LictTransfer `protobuf:"bytes,3,opt,name=transfer,proto3,oneof" json:"transfer,omitempty"`
+}
+type LictTx_Burn struct {
+	Burn *LictBurn `protobuf:"bytes,4,opt,name=burn,proto3,oneof" json:"burn,omitempty"`
+}
+type LictTx_PowerMeasure struct {
+	PowerMeasure *LictPowerMeasure `protobuf:"bytes,7,opt,name=power_measure,json=powerMeasure,proto3,oneof" json:"power_measure,omitempty"`
+}
+type LictTx_Propose struct {
+	Propose *LictPropose `protobuf:"bytes,8,opt,name=propose,proto3,oneof" json:"propose,omitempty"`
+}
+type LictTx_Approve struct {
+	Approve *LictApprove `protobuf:"bytes,9,opt,name=approve,proto3,oneof" json:"approve,omitempty"`
+}
+type LictTx_Execute struct {
+	Execute *LictExecute `protobuf:"bytes,10,opt,name=execute,proto3,oneof" json:"execute,omitempty"`
+}
+
+func (*LictTx_Transfer) isLictTx_Msg()     {}
+func (*LictTx_Burn) isLictTx_Msg()         {}
+func (*LictTx_PowerMeasure) isLictTx_Msg() {}
+func (*LictTx_Propose) isLictTx_Msg()      {}
+func (*LictTx_Approve) isLictTx_Msg()      {}
+func (*LictTx_Execute) isLictTx_Msg()      {}
+
+func (m *LictTx) GetMsg() isLictTx_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *LictTx) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *LictTx) GetTransfer() *LictTransfer {
+	if x, ok := m.GetMsg().(*LictTx_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (m *LictTx) GetBurn() *LictBurn {
+	if x, ok := m.GetMsg().(*LictTx_Burn); ok {
+		return x.Burn
+	}
+	return nil
+}
+
+func (m *LictTx) GetPowerMeasure() *LictPowerMeasure {
+	if x, ok := m.GetMsg().(*LictTx_PowerMeasure); ok {
+		return x.PowerMeasure
+	}
+	return nil
+}
+
+func (m *LictTx) GetPropose() *LictPropose {
+	if x, ok := m.GetMsg().(*LictTx_Propose); ok {
+		return x.Propose
+	}
+	return nil
+}
+
+func (m *LictTx) GetApprove() *LictApprove {
+	if x, ok := m.GetMsg().(*LictTx_Approve); ok {
+		return x.Approve
+	}
+	return nil
+}
+
+func (m *LictTx) GetExecute() *LictExecute {
+	if x, ok := m.GetMsg().(*LictTx_Execute); ok {
+		return x.Execute
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*LictTx) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*LictTx_Transfer)(nil),
+		(*LictTx_Burn)(nil),
+		(*LictTx_PowerMeasure)(nil),
+		(*LictTx_Propose)(nil),
+		(*LictTx_Approve)(nil),
+		(*LictTx_Execute)(nil),
+	}
+}
+
+// Mint new tokens on the balance of an allowed meter
+type LictMint struct {
+	Meter                string   `protobuf:"bytes,1,opt,name=meter,proto3" json:"meter,omitempty"`
+	Amount               uint64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LictMint) Reset()         { *m = LictMint{} }
+func (m *LictMint) String() string { return proto.CompactTextString(m) }
+func (*LictMint) ProtoMessage()    {}
+func (*LictMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{1}
+}
+func (m *LictMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictMint.Merge(m, src)
+}
+func (m *LictMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictMint proto.InternalMessageInfo
+
+func (m *LictMint) GetMeter() string {
+	if m != nil {
+		return m.Meter
+	}
+	return ""
+}
+
+func (m *LictMint) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// Transfer tokens from the sender to an allowed meter
+type LictTransfer struct {
+	Recipient            string   `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount               uint64   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LictTransfer) Reset()         { *m = LictTransfer{} }
+func (m *LictTransfer) String() string { return proto.CompactTextString(m) }
+func (*LictTransfer) ProtoMessage()    {}
+func (*LictTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{2}
+}
+func (m *LictTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictTransfer.Merge(m, src)
+}
+func (m *LictTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictTransfer proto.InternalMessageInfo
+
+func (m *LictTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *LictTransfer) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// Burn tokens from the balance of the sender
+type LictBurn struct {
+	Amount               uint64   `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LictBurn) Reset()         { *m = LictBurn{} }
+func (m *LictBurn) String() string { return proto.CompactTextString(m) }
+func (*LictBurn) ProtoMessage()    {}
+func (*LictBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{3}
+}
+func (m *LictBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictBurn.Merge(m, src)
+}
+func (m *LictBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictBurn proto.InternalMessageInfo
+
+func (m *LictBurn) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// Replace the community admins and the number of approvals required to
+// execute a proposal
+type LictSetAdmins struct {
+	Admins               []string `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	Threshold            uint32   `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LictSetAdmins) Reset()         { *m = LictSetAdmins{} }
+func (m *LictSetAdmins) String() string { return proto.CompactTextString(m) }
+func (*LictSetAdmins) ProtoMessage()    {}
+func (*LictSetAdmins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{4}
+}
+func (m *LictSetAdmins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictSetAdmins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictSetAdmins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictSetAdmins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictSetAdmins.Merge(m, src)
+}
+func (m *LictSetAdmins) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictSetAdmins) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictSetAdmins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictSetAdmins proto.InternalMessageInfo
+
+func (m *LictSetAdmins) GetAdmins() []string {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+func (m *LictSetAdmins) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// Replace the list of meters allowed in the community
+type LictSetAllowedMeters struct {
+	// Amino encoded public keys (crypto.PubKey) of the meters, which sign their
+	// transactions and queries
+	PubKeys              [][]byte `protobuf:"bytes,2,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LictSetAllowedMeters) Reset()         { *m = LictSetAllowedMeters{} }
+func (m *LictSetAllowedMeters) String() string { return proto.CompactTextString(m) }
+func (*LictSetAllowedMeters) ProtoMessage()    {}
+func (*LictSetAllowedMeters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{5}
+}
+func (m *LictSetAllowedMeters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictSetAllowedMeters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictSetAllowedMeters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictSetAllowedMeters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictSetAllowedMeters.Merge(m, src)
+}
+func (m *LictSetAllowedMeters) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictSetAllowedMeters) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictSetAllowedMeters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictSetAllowedMeters proto.InternalMessageInfo
+
+func (m *LictSetAllowedMeters) GetPubKeys() [][]byte {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+// Add, update or remove (power 0) a validator
+type LictSetValidator struct {
+	// ed25519 public key of the validator
+	PubKey               []byte   `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Power                int64    `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LictSetValidator) Reset()         { *m = LictSetValidator{} }
+func (m *LictSetValidator) String() string { return proto.CompactTextString(m) }
+func (*LictSetValidator) ProtoMessage()    {}
+func (*LictSetValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{6}
+}
+func (m *LictSetValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictSetValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictSetValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictSetValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictSetValidator.Merge(m, src)
+}
+func (m *LictSetValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictSetValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictSetValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictSetValidator proto.InternalMessageInfo
+
+func (m *LictSetValidator) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *LictSetValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// Set who can read the results of a query path: public, meters, owner or
+// admins
+type LictSetQueryPolicy struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Policy               string   `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LictSetQueryPolicy) Reset()         { *m = LictSetQueryPolicy{} }
+func (m *LictSetQueryPolicy) String() string { return proto.CompactTextString(m) }
+func (*LictSetQueryPolicy) ProtoMessage()    {}
+func (*LictSetQueryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{7}
+}
+func (m *LictSetQueryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictSetQueryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictSetQueryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictSetQueryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictSetQueryPolicy.Merge(m, src)
+}
+func (m *LictSetQueryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictSetQueryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictSetQueryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictSetQueryPolicy proto.InternalMessageInfo
+
+func (m *LictSetQueryPolicy) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *LictSetQueryPolicy) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+// Store a reading of the sender meter
+type LictPowerMeasure struct {
+	// Unix time of the reading, in seconds
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Measured power, in the unit of the meter
+	Value                int64    `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LictPowerMeasure) Reset()         { *m = LictPowerMeasure{} }
+func (m *LictPowerMeasure) String() string { return proto.CompactTextString(m) }
+func (*LictPowerMeasure) ProtoMessage()    {}
+func (*LictPowerMeasure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{8}
+}
+func (m *LictPowerMeasure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictPowerMeasure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictPowerMeasure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictPowerMeasure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictPowerMeasure.Merge(m, src)
+}
+func (m *LictPowerMeasure) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictPowerMeasure) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictPowerMeasure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictPowerMeasure proto.InternalMessageInfo
+
+func (m *LictPowerMeasure) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *LictPowerMeasure) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// Admin action executed once approved by the threshold of admins
+type LictProposal struct {
+	// Types that are valid to be assigned to Action:
+	//	*LictProposal_Mint
+	//	*LictProposal_SetAllowedMeters
+	//	*LictProposal_SetAdmins
+	//	*LictProposal_SetValidator
+	//	*LictProposal_SetQueryPolicy
+	Action               isLictProposal_Action `protobuf_oneof:"action"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *LictProposal) Reset()         { *m = LictProposal{} }
+func (m *LictProposal) String() string { return proto.CompactTextString(m) }
+func (*LictProposal) ProtoMessage()    {}
+func (*LictProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{9}
+}
+func (m *LictProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictProposal.Merge(m, src)
+}
+func (m *LictProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictProposal proto.InternalMessageInfo
+
+type isLictProposal_Action interface {
+	isLictProposal_Action()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type LictProposal_Mint struct {
+	Mint *LictMint `protobuf:"bytes,1,opt,name=mint,proto3,oneof" json:"mint,omitempty"`
+}
+type LictProposal_SetAllowedMeters struct {
+	SetAllowedMeters *LictSetAllowedMeters `protobuf:"bytes,2,opt,name=set_allowed_meters,json=setAllowedMeters,proto3,oneof" json:"set_allowed_meters,omitempty"`
+}
+type LictProposal_SetAdmins struct {
+	SetAdmins *LictSetAdmins `protobuf:"bytes,3,opt,name=set_admins,json=setAdmins,proto3,oneof" json:"set_admins,omitempty"`
+}
+type LictProposal_SetValidator struct {
+	SetValidator *LictSetValidator `protobuf:"bytes,4,opt,name=set_validator,json=setValidator,proto3,oneof" json:"set_validator,omitempty"`
+}
+type LictProposal_SetQueryPolicy struct {
+	SetQueryPolicy *LictSetQueryPolicy `protobuf:"bytes,5,opt,name=set_query_policy,json=setQueryPolicy,proto3,oneof" json:"set_query_policy,omitempty"`
+}
+
+func (*LictProposal_Mint) isLictProposal_Action()             {}
+func (*LictProposal_SetAllowedMeters) isLictProposal_Action() {}
+func (*LictProposal_SetAdmins) isLictProposal_Action()        {}
+func (*LictProposal_SetValidator) isLictProposal_Action()     {}
+func (*LictProposal_SetQueryPolicy) isLictProposal_Action()   {}
+
+func (m *LictProposal) GetAction() isLictProposal_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *LictProposal) GetMint() *LictMint {
+	if x, ok := m.GetAction().(*LictProposal_Mint); ok {
+		return x.Mint
+	}
+	return nil
+}
+
+func (m *LictProposal) GetSetAllowedMeters() *LictSetAllowedMeters {
+	if x, ok := m.GetAction().(*LictProposal_SetAllowedMeters); ok {
+		return x.SetAllowedMeters
+	}
+	return nil
+}
+
+func (m *LictProposal) GetSetAdmins() *LictSetAdmins {
+	if x, ok := m.GetAction().(*LictProposal_SetAdmins); ok {
+		return x.SetAdmins
+	}
+	return nil
+}
+
+func (m *LictProposal) GetSetValidator() *LictSetValidator {
+	if x, ok := m.GetAction().(*LictProposal_SetValidator); ok {
+		return x.SetValidator
+	}
+	return nil
+}
+
+func (m *LictProposal) GetSetQueryPolicy() *LictSetQueryPolicy {
+	if x, ok := m.GetAction().(*LictProposal_SetQueryPolicy); ok {
+		return x.SetQueryPolicy
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*LictProposal) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*LictProposal_Mint)(nil),
+		(*LictProposal_SetAllowedMeters)(nil),
+		(*LictProposal_SetAdmins)(nil),
+		(*LictProposal_SetValidator)(nil),
+		(*LictProposal_SetQueryPolicy)(nil),
+	}
+}
+
+// Submit a proposal, approved by the proposer
+type LictPropose struct {
+	Proposal             *LictProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *LictPropose) Reset()         { *m = LictPropose{} }
+func (m *LictPropose) String() string { return proto.CompactTextString(m) }
+func (*LictPropose) ProtoMessage()    {}
+func (*LictPropose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{10}
+}
+func (m *LictPropose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictPropose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictPropose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictPropose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictPropose.Merge(m, src)
+}
+func (m *LictPropose) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictPropose) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictPropose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictPropose proto.InternalMessageInfo
+
+func (m *LictPropose) GetProposal() *LictProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
+// Approve a pending proposal
+type LictApprove struct {
+	ProposalId           uint64   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LictApprove) Reset()         { *m = LictApprove{} }
+func (m *LictApprove) String() string { return proto.CompactTextString(m) }
+func (*LictApprove) ProtoMessage()    {}
+func (*LictApprove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{11}
+}
+func (m *LictApprove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictApprove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictApprove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictApprove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictApprove.Merge(m, src)
+}
+func (m *LictApprove) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictApprove) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictApprove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictApprove proto.InternalMessageInfo
+
+func (m *LictApprove) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// Execute a proposal approved by the threshold of admins
+type LictExecute struct {
+	ProposalId           uint64   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LictExecute) Reset()         { *m = LictExecute{} }
+func (m *LictExecute) String() string { return proto.CompactTextString(m) }
+func (*LictExecute) ProtoMessage()    {}
+func (*LictExecute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7017fac5ebcdf8c6, []int{12}
+}
+func (m *LictExecute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LictExecute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LictExecute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LictExecute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LictExecute.Merge(m, src)
+}
+func (m *LictExecute) XXX_Size() int {
+	return m.Size()
+}
+func (m *LictExecute) XXX_DiscardUnknown() {
+	xxx_messageInfo_LictExecute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LictExecute proto.InternalMessageInfo
+
+func (m *LictExecute) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*LictTx)(nil), "tendermint.abci.types.LictTx")
+	golang_proto.RegisterType((*LictTx)(nil), "tendermint.abci.types.LictTx")
+	proto.RegisterType((*LictMint)(nil), "tendermint.abci.types.LictMint")
+	golang_proto.RegisterType((*LictMint)(nil), "tendermint.abci.types.LictMint")
+	proto.RegisterType((*LictTransfer)(nil), "tendermint.abci.types.LictTransfer")
+	golang_proto.RegisterType((*LictTransfer)(nil), "tendermint.abci.types.LictTransfer")
+	proto.RegisterType((*LictBurn)(nil), "tendermint.abci.types.LictBurn")
+	golang_proto.RegisterType((*LictBurn)(nil), "tendermint.abci.types.LictBurn")
+	proto.RegisterType((*LictSetAdmins)(nil), "tendermint.abci.types.LictSetAdmins")
+	golang_proto.RegisterType((*LictSetAdmins)(nil), "tendermint.abci.types.LictSetAdmins")
+	proto.RegisterType((*LictSetAllowedMeters)(nil), "tendermint.abci.types.LictSetAllowedMeters")
+	golang_proto.RegisterType((*LictSetAllowedMeters)(nil), "tendermint.abci.types.LictSetAllowedMeters")
+	proto.RegisterType((*LictSetValidator)(nil), "tendermint.abci.types.LictSetValidator")
+	golang_proto.RegisterType((*LictSetValidator)(nil), "tendermint.abci.types.LictSetValidator")
+	proto.RegisterType((*LictSetQueryPolicy)(nil), "tendermint.abci.types.LictSetQueryPolicy")
+	golang_proto.RegisterType((*LictSetQueryPolicy)(nil), "tendermint.abci.types.LictSetQueryPolicy")
+	proto.RegisterType((*LictPowerMeasure)(nil), "tendermint.abci.types.LictPowerMeasure")
+	golang_proto.RegisterType((*LictPowerMeasure)(nil), "tendermint.abci.types.LictPowerMeasure")
+	proto.RegisterType((*LictProposal)(nil), "tendermint.abci.types.LictProposal")
+	golang_proto.RegisterType((*LictProposal)(nil), "tendermint.abci.types.LictProposal")
+	proto.RegisterType((*LictPropose)(nil), "tendermint.abci.types.LictPropose")
+	golang_proto.RegisterType((*LictPropose)(nil), "tendermint.abci.types.LictPropose")
+	proto.RegisterType((*LictApprove)(nil), "tendermint.abci.types.LictApprove")
+	golang_proto.RegisterType((*LictApprove)(nil), "tendermint.abci.types.LictApprove")
+	proto.RegisterType((*LictExecute)(nil), "tendermint.abci.types.LictExecute")
+	golang_proto.RegisterType((*LictExecute)(nil), "tendermint.abci.types.LictExecute")
+}
+
+func init() { proto.RegisterFile("abci/types/tenderlic.proto", fileDescriptor_7017fac5ebcdf8c6) }
+func init() { golang_proto.RegisterFile("abci/types/tenderlic.proto", fileDescriptor_7017fac5ebcdf8c6) }
+
+var fileDescriptor_7017fac5ebcdf8c6 = []byte{
+	// 768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xd1, 0x6e, 0xdb, 0x36,
+	0x14, 0x35, 0x2d, 0xd9, 0x96, 0xaf, 0xed, 0xc1, 0x20, 0xb2, 0x4d, 0x0b, 0x06, 0xc7, 0xd0, 0x06,
+	0xcc, 0x43, 0x30, 0x1b, 0xc8, 0x30, 0x6c, 0x4f, 0xdb, 0x6c, 0x2c, 0x83, 0xe3, 0x2d, 0x41, 0xc6,
+	0x6d, 0x7d, 0x68, 0x1f, 0x0c, 0x59, 0x66, 0x6c, 0xa1, 0x92, 0xa8, 0x92, 0x94, 0x13, 0xff, 0x4a,
+	0xbf, 0xa0, 0x9f, 0xd0, 0xc7, 0xbe, 0x14, 0xe8, 0x63, 0x3f, 0xa1, 0x75, 0x7f, 0xa2, 0x8f, 0x05,
+	0x29, 0x29, 0x56, 0x82, 0xd6, 0x7e, 0xe3, 0xbd, 0x3a, 0xe7, 0xf0, 0x8a, 0xe7, 0xf2, 0x12, 0x0e,
+	0xdd, 0x99, 0xe7, 0x0f, 0xe4, 0x3a, 0xa6, 0x62, 0x20, 0x69, 0x34, 0xa7, 0x3c, 0xf0, 0xbd, 0x7e,
+	0xcc, 0x99, 0x64, 0xf8, 0xf3, 0x34, 0x11, 0xfa, 0x91, 0xec, 0x2b, 0x58, 0x5f, 0xc3, 0x0e, 0x7f,
+	0x58, 0xf8, 0x72, 0x99, 0xcc, 0xfa, 0x1e, 0x0b, 0x07, 0x0b, 0xb6, 0x60, 0x03, 0x8d, 0x9e, 0x25,
+	0x57, 0x3a, 0xd2, 0x81, 0x5e, 0xa5, 0x2a, 0xce, 0x4b, 0x03, 0xaa, 0x7f, 0xfb, 0x9e, 0xfc, 0xef,
+	0x06, 0xdb, 0x50, 0x5b, 0x51, 0x2e, 0x7c, 0x16, 0xd9, 0xa8, 0x8b, 0x7a, 0x2d, 0x92, 0x87, 0x78,
+	0x08, 0x96, 0xe4, 0x6e, 0x24, 0xae, 0x28, 0xb7, 0x8d, 0x2e, 0xea, 0x35, 0x4e, 0xbe, 0xe9, 0x7f,
+	0x74, 0xf7, 0xbe, 0x96, 0xca, 0xa0, 0xe3, 0x12, 0xb9, 0xa5, 0xe1, 0x9f, 0xc0, 0x9c, 0x25, 0x3c,
+	0xb2, 0x4d, 0x4d, 0x3f, 0xda, 0x41, 0x1f, 0x25, 0x3c, 0x1a, 0x97, 0x88, 0x86, 0xe3, 0x0b, 0x68,
+	0xc5, 0xec, 0x9a, 0xf2, 0x69, 0x48, 0x5d, 0x91, 0x70, 0x6a, 0xd7, 0x34, 0xff, 0xbb, 0x1d, 0xfc,
+	0x4b, 0x85, 0x3f, 0x4f, 0xe1, 0xe3, 0x12, 0x69, 0xc6, 0x85, 0x18, 0xff, 0x0a, 0xb5, 0x98, 0xb3,
+	0x98, 0x09, 0x6a, 0x5b, 0x5a, 0xc9, 0xd9, 0xa5, 0x94, 0x22, 0xc7, 0x25, 0x92, 0x93, 0x14, 0xdf,
+	0x8d, 0x63, 0xce, 0x56, 0xd4, 0xae, 0xef, 0xe5, 0x0f, 0x53, 0xa4, 0xe2, 0x67, 0x24, 0xc5, 0xa7,
+	0x37, 0xd4, 0x4b, 0x24, 0xb5, 0x61, 0x2f, 0xff, 0x34, 0x45, 0x2a, 0x7e, 0x46, 0x1a, 0x55, 0xc0,
+	0x08, 0xc5, 0x62, 0x62, 0x5a, 0xe5, 0xb6, 0x31, 0x31, 0xad, 0x4a, 0xbb, 0x3a, 0x31, 0xad, 0x6a,
+	0xbb, 0xe6, 0xfc, 0x02, 0x96, 0xa2, 0x9c, 0xfb, 0x91, 0xc4, 0x07, 0x50, 0x09, 0xa9, 0xa4, 0x5c,
+	0xdb, 0x58, 0x27, 0x69, 0x80, 0xbf, 0x80, 0xaa, 0x1b, 0xb2, 0x24, 0x92, 0x76, 0xb9, 0x8b, 0x7a,
+	0x26, 0xc9, 0x22, 0xe7, 0x0f, 0x68, 0x16, 0x5d, 0xc3, 0x5f, 0x43, 0x9d, 0x53, 0xcf, 0x8f, 0x7d,
+	0x1a, 0xc9, 0x4c, 0x61, 0x9b, 0xf8, 0xa4, 0x8a, 0x03, 0x56, 0x6e, 0x5e, 0x01, 0x83, 0xee, 0x60,
+	0x4e, 0xa1, 0xa5, 0x30, 0xff, 0x52, 0x39, 0x9c, 0x87, 0x7e, 0x24, 0x34, 0x50, 0xaf, 0x6c, 0xd4,
+	0x35, 0x7a, 0x75, 0x92, 0x45, 0xaa, 0x04, 0xb9, 0xe4, 0x54, 0x2c, 0x59, 0x30, 0xd7, 0xfb, 0xb4,
+	0xc8, 0x36, 0xe1, 0xfc, 0x0c, 0x07, 0xb9, 0x4c, 0x10, 0xb0, 0x6b, 0x3a, 0x3f, 0x57, 0xff, 0x27,
+	0xf0, 0x57, 0x60, 0xc5, 0xc9, 0x6c, 0xfa, 0x98, 0xae, 0x85, 0x5d, 0xee, 0x1a, 0xbd, 0x26, 0xa9,
+	0xc5, 0xc9, 0xec, 0x2f, 0xba, 0x16, 0x13, 0xd3, 0x42, 0xed, 0xb2, 0x33, 0x84, 0x76, 0x46, 0x7c,
+	0xe0, 0x06, 0xfe, 0xdc, 0x95, 0x8c, 0xe3, 0x2f, 0xa1, 0x96, 0x91, 0x74, 0xb1, 0x4d, 0x52, 0x4d,
+	0x39, 0xea, 0x10, 0x75, 0xe7, 0xe8, 0xfd, 0x0d, 0x92, 0x06, 0xce, 0xef, 0x80, 0x33, 0x89, 0x7f,
+	0x12, 0xca, 0xd7, 0x97, 0x2c, 0xf0, 0xbd, 0x35, 0xc6, 0x60, 0xc6, 0xae, 0x5c, 0x66, 0xa7, 0xa5,
+	0xd7, 0xea, 0xdf, 0x62, 0xfd, 0x55, 0x0b, 0xd4, 0x49, 0x16, 0x39, 0x7f, 0x42, 0xfb, 0x7e, 0x97,
+	0xea, 0xff, 0xf5, 0x43, 0x2a, 0xa4, 0x1b, 0xc6, 0x5a, 0xc4, 0x20, 0xdb, 0x84, 0xaa, 0x64, 0xe5,
+	0x06, 0x09, 0xcd, 0x2b, 0xd1, 0x81, 0xf3, 0xd4, 0x80, 0xe6, 0xb6, 0x49, 0xdd, 0x40, 0xdd, 0x30,
+	0xd5, 0x44, 0x36, 0xda, 0x7b, 0xc3, 0x54, 0x93, 0xa8, 0x1b, 0xa6, 0xbe, 0xe1, 0x47, 0x80, 0x05,
+	0x95, 0x53, 0x37, 0x3d, 0xca, 0xa9, 0xee, 0x15, 0xa1, 0xb7, 0x6a, 0x9c, 0x1c, 0xef, 0x10, 0xb9,
+	0x7f, 0xfc, 0xe3, 0x12, 0x69, 0x8b, 0xfb, 0x96, 0x9c, 0x02, 0x68, 0xf1, 0xd4, 0xe4, 0x74, 0x74,
+	0x7c, 0xbb, 0x47, 0x54, 0x63, 0xc7, 0x25, 0x52, 0x17, 0x79, 0xa0, 0xa6, 0x80, 0x92, 0x59, 0xe5,
+	0xae, 0xd9, 0xe6, 0xde, 0x29, 0x50, 0x34, 0x59, 0x4d, 0x01, 0x51, 0x34, 0xfd, 0x7f, 0x50, 0xa5,
+	0x4e, 0x9f, 0x28, 0x0b, 0xa7, 0x99, 0x4b, 0x15, 0x2d, 0xf9, 0xfd, 0x6e, 0xc9, 0x82, 0xe9, 0xe3,
+	0x12, 0xf9, 0x4c, 0xdc, 0xc9, 0x8c, 0x2c, 0xa8, 0xba, 0x9e, 0xf4, 0x59, 0xe4, 0x5c, 0x40, 0xa3,
+	0x30, 0x40, 0xf0, 0x6f, 0x60, 0xc5, 0x99, 0x4d, 0x36, 0xda, 0x3b, 0x3f, 0x73, 0x47, 0xc9, 0x2d,
+	0xc9, 0xe9, 0x43, 0xa3, 0x30, 0x50, 0xf0, 0x11, 0x34, 0xf2, 0x4f, 0x53, 0x7f, 0x9e, 0xdd, 0x32,
+	0xc8, 0x53, 0x67, 0xf3, 0x1c, 0x9f, 0x0d, 0x90, 0xbd, 0xf8, 0xd1, 0xd9, 0xfb, 0xb7, 0x1d, 0xf4,
+	0x6c, 0xd3, 0x41, 0xcf, 0x37, 0x1d, 0xf4, 0x6a, 0xd3, 0x41, 0xaf, 0x37, 0x1d, 0xf4, 0x66, 0xd3,
+	0x41, 0x2f, 0xde, 0x75, 0xd0, 0xc3, 0xe3, 0xc2, 0x93, 0xb2, 0x2d, 0xbb, 0xb8, 0xdc, 0x3e, 0x53,
+	0xb3, 0xaa, 0x7e, 0x57, 0x7e, 0xfc, 0x30, 0x00, 0xbe, 0x1d, 0x7a, 0xf9, 0xbb, 0x06, 0x00, 0x00,
+}
+
+func (this *LictTx) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictTx)
+	if !ok {
+		that2, ok := that.(LictTx)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if that1.Msg == nil {
+		if this.Msg != nil {
+			return false
+		}
+	} else if this.Msg == nil {
+		return false
+	} else if !this.Msg.Equal(that1.Msg) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictTx_Transfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictTx_Transfer)
+	if !ok {
+		that2, ok := that.(LictTx_Transfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Transfer.Equal(that1.Transfer) {
+		return false
+	}
+	return true
+}
+func (this *LictTx_Burn) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictTx_Burn)
+	if !ok {
+		that2, ok := that.(LictTx_Burn)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Burn.Equal(that1.Burn) {
+		return false
+	}
+	return true
+}
+func (this *LictTx_PowerMeasure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictTx_PowerMeasure)
+	if !ok {
+		that2, ok := that.(LictTx_PowerMeasure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.PowerMeasure.Equal(that1.PowerMeasure) {
+		return false
+	}
+	return true
+}
+func (this *LictTx_Propose) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictTx_Propose)
+	if !ok {
+		that2, ok := that.(LictTx_Propose)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Propose.Equal(that1.Propose) {
+		return false
+	}
+	return true
+}
+func (this *LictTx_Approve) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictTx_Approve)
+	if !ok {
+		that2, ok := that.(LictTx_Approve)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Approve.Equal(that1.Approve) {
+		return false
+	}
+	return true
+}
+func (this *LictTx_Execute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictTx_Execute)
+	if !ok {
+		that2, ok := that.(LictTx_Execute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Execute.Equal(that1.Execute) {
+		return false
+	}
+	return true
+}
+func (this *LictMint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictMint)
+	if !ok {
+		that2, ok := that.(LictMint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Meter != that1.Meter {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictTransfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictTransfer)
+	if !ok {
+		that2, ok := that.(LictTransfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictBurn) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictBurn)
+	if !ok {
+		that2, ok := that.(LictBurn)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictSetAdmins) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictSetAdmins)
+	if !ok {
+		that2, ok := that.(LictSetAdmins)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Admins) != len(that1.Admins) {
+		return false
+	}
+	for i := range this.Admins {
+		if this.Admins[i] != that1.Admins[i] {
+			return false
+		}
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictSetAllowedMeters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictSetAllowedMeters)
+	if !ok {
+		that2, ok := that.(LictSetAllowedMeters)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.PubKeys) != len(that1.PubKeys) {
+		return false
+	}
+	for i := range this.PubKeys {
+		if !bytes.Equal(this.PubKeys[i], that1.PubKeys[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictSetValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictSetValidator)
+	if !ok {
+		that2, ok := that.(LictSetValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictSetQueryPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictSetQueryPolicy)
+	if !ok {
+		that2, ok := that.(LictSetQueryPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.Policy != that1.Policy {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictPowerMeasure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictPowerMeasure)
+	if !ok {
+		that2, ok := that.(LictPowerMeasure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictProposal)
+	if !ok {
+		that2, ok := that.(LictProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Action == nil {
+		if this.Action != nil {
+			return false
+		}
+	} else if this.Action == nil {
+		return false
+	} else if !this.Action.Equal(that1.Action) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictProposal_Mint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictProposal_Mint)
+	if !ok {
+		that2, ok := that.(LictProposal_Mint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Mint.Equal(that1.Mint) {
+		return false
+	}
+	return true
+}
+func (this *LictProposal_SetAllowedMeters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictProposal_SetAllowedMeters)
+	if !ok {
+		that2, ok := that.(LictProposal_SetAllowedMeters)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SetAllowedMeters.Equal(that1.SetAllowedMeters) {
+		return false
+	}
+	return true
+}
+func (this *LictProposal_SetAdmins) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictProposal_SetAdmins)
+	if !ok {
+		that2, ok := that.(LictProposal_SetAdmins)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SetAdmins.Equal(that1.SetAdmins) {
+		return false
+	}
+	return true
+}
+func (this *LictProposal_SetValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictProposal_SetValidator)
+	if !ok {
+		that2, ok := that.(LictProposal_SetValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SetValidator.Equal(that1.SetValidator) {
+		return false
+	}
+	return true
+}
+func (this *LictProposal_SetQueryPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictProposal_SetQueryPolicy)
+	if !ok {
+		that2, ok := that.(LictProposal_SetQueryPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SetQueryPolicy.Equal(that1.SetQueryPolicy) {
+		return false
+	}
+	return true
+}
+func (this *LictPropose) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictPropose)
+	if !ok {
+		that2, ok := that.(LictPropose)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Proposal.Equal(that1.Proposal) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictApprove) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictApprove)
+	if !ok {
+		that2, ok := that.(LictApprove)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *LictExecute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LictExecute)
+	if !ok {
+		that2, ok := that.(LictExecute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (m *LictTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Msg != nil {
+		{
+			size := m.Msg.Size()
+			i -= size
+			if _, err := m.Msg.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintTenderlic(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictTx_Transfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictTx_Transfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Transfer != nil {
+		{
+			size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LictTx_Burn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictTx_Burn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Burn != nil {
+		{
+			size, err := m.Burn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LictTx_PowerMeasure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictTx_PowerMeasure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PowerMeasure != nil {
+		{
+			size, err := m.PowerMeasure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LictTx_Propose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictTx_Propose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Propose != nil {
+		{
+			size, err := m.Propose.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LictTx_Approve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictTx_Approve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Approve != nil {
+		{
+			size, err := m.Approve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LictTx_Execute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictTx_Execute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Execute != nil {
+		{
+			size, err := m.Execute.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LictMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTenderlic(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Meter) > 0 {
+		i -= len(m.Meter)
+		copy(dAtA[i:], m.Meter)
+		i = encodeVarintTenderlic(dAtA, i, uint64(len(m.Meter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTenderlic(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTenderlic(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTenderlic(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictSetAdmins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictSetAdmins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictSetAdmins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTenderlic(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintTenderlic(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictSetAllowedMeters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictSetAllowedMeters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictSetAllowedMeters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintTenderlic(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictSetValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictSetValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictSetValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Power != 0 {
+		i = encodeVarintTenderlic(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTenderlic(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictSetQueryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictSetQueryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictSetQueryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintTenderlic(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTenderlic(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictPowerMeasure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictPowerMeasure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictPowerMeasure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value != 0 {
+		i = encodeVarintTenderlic(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTenderlic(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Action != nil {
+		{
+			size := m.Action.Size()
+			i -= size
+			if _, err := m.Action.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictProposal_Mint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictProposal_Mint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Mint != nil {
+		{
+			size, err := m.Mint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LictProposal_SetAllowedMeters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictProposal_SetAllowedMeters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetAllowedMeters != nil {
+		{
+			size, err := m.SetAllowedMeters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LictProposal_SetAdmins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictProposal_SetAdmins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetAdmins != nil {
+		{
+			size, err := m.SetAdmins.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LictProposal_SetValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictProposal_SetValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetValidator != nil {
+		{
+			size, err := m.SetValidator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LictProposal_SetQueryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictProposal_SetQueryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetQueryPolicy != nil {
+		{
+			size, err := m.SetQueryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LictPropose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictPropose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictPropose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTenderlic(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictApprove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictApprove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictApprove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTenderlic(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LictExecute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LictExecute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LictExecute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTenderlic(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTenderlic(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTenderlic(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedLictTx(r randyTenderlic, easy bool) *LictTx {
+	this := &LictTx{}
+	this.Version = uint32(r.Uint32())
+	oneofNumber_Msg := []int32{3, 4, 7, 8, 9, 10}[r.Intn(6)]
+	switch oneofNumber_Msg {
+	case 3:
+		this.Msg = NewPopulatedLictTx_Transfer(r, easy)
+	case 4:
+		this.Msg = NewPopulatedLictTx_Burn(r, easy)
+	case 7:
+		this.Msg = NewPopulatedLictTx_PowerMeasure(r, easy)
+	case 8:
+		this.Msg = NewPopulatedLictTx_Propose(r, easy)
+	case 9:
+		this.Msg = NewPopulatedLictTx_Approve(r, easy)
+	case 10:
+		this.Msg = NewPopulatedLictTx_Execute(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 11)
+	}
+	return this
+}
+
+func NewPopulatedLictTx_Transfer(r randyTenderlic, easy bool) *LictTx_Transfer {
+	this := &LictTx_Transfer{}
+	this.Transfer = NewPopulatedLictTransfer(r, easy)
+	return this
+}
+func NewPopulatedLictTx_Burn(r randyTenderlic, easy bool) *LictTx_Burn {
+	this := &LictTx_Burn{}
+	this.Burn = NewPopulatedLictBurn(r, easy)
+	return this
+}
+func NewPopulatedLictTx_PowerMeasure(r randyTenderlic, easy bool) *LictTx_PowerMeasure {
+	this := &LictTx_PowerMeasure{}
+	this.PowerMeasure = NewPopulatedLictPowerMeasure(r, easy)
+	return this
+}
+func NewPopulatedLictTx_Propose(r randyTenderlic, easy bool) *LictTx_Propose {
+	this := &LictTx_Propose{}
+	this.Propose = NewPopulatedLictPropose(r, easy)
+	return this
+}
+func NewPopulatedLictTx_Approve(r randyTenderlic, easy bool) *LictTx_Approve {
+	this := &LictTx_Approve{}
+	this.Approve = NewPopulatedLictApprove(r, easy)
+	return this
+}
+func NewPopulatedLictTx_Execute(r randyTenderlic, easy bool) *LictTx_Execute {
+	this := &LictTx_Execute{}
+	this.Execute = NewPopulatedLictExecute(r, easy)
+	return this
+}
+func NewPopulatedLictMint(r randyTenderlic, easy bool) *LictMint {
+	this := &LictMint{}
+	this.Meter = string(randStringTenderlic(r))
+	this.Amount = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedLictTransfer(r randyTenderlic, easy bool) *LictTransfer {
+	this := &LictTransfer{}
+	this.Recipient = string(randStringTenderlic(r))
+	this.Amount = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedLictBurn(r randyTenderlic, easy bool) *LictBurn {
+	this := &LictBurn{}
+	this.Amount = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedLictSetAdmins(r randyTenderlic, easy bool) *LictSetAdmins {
+	this := &LictSetAdmins{}
+	v1 := r.Intn(10)
+	this.Admins = make([]string, v1)
+	for i := 0; i < v1; i++ {
+		this.Admins[i] = string(randStringTenderlic(r))
+	}
+	this.Threshold = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedLictSetAllowedMeters(r randyTenderlic, easy bool) *LictSetAllowedMeters {
+	this := &LictSetAllowedMeters{}
+	v2 := r.Intn(10)
+	this.PubKeys = make([][]byte, v2)
+	for i := 0; i < v2; i++ {
+		v3 := r.Intn(100)
+		this.PubKeys[i] = make([]byte, v3)
+		for j := 0; j < v3; j++ {
+			this.PubKeys[i][j] = byte(r.Intn(256))
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedLictSetValidator(r randyTenderlic, easy bool) *LictSetValidator {
+	this := &LictSetValidator{}
+	v3 := r.Intn(100)
+	this.PubKey = make([]byte, v3)
+	for i := 0; i < v3; i++ {
+		this.PubKey[i] = byte(r.Intn(256))
+	}
+	this.Power = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Power *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedLictSetQueryPolicy(r randyTenderlic, easy bool) *LictSetQueryPolicy {
+	this := &LictSetQueryPolicy{}
+	this.Path = string(randStringTenderlic(r))
+	this.Policy = string(randStringTenderlic(r))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedLictPowerMeasure(r randyTenderlic, easy bool) *LictPowerMeasure {
+	this := &LictPowerMeasure{}
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	this.Value = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Value *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedLictProposal(r randyTenderlic, easy bool) *LictProposal {
+	this := &LictProposal{}
+	oneofNumber_Action := []int32{1, 2, 3, 4, 5}[r.Intn(5)]
+	switch oneofNumber_Action {
+	case 1:
+		this.Action = NewPopulatedLictProposal_Mint(r, easy)
+	case 2:
+		this.Action = NewPopulatedLictProposal_SetAllowedMeters(r, easy)
+	case 3:
+		this.Action = NewPopulatedLictProposal_SetAdmins(r, easy)
+	case 4:
+		this.Action = NewPopulatedLictProposal_SetValidator(r, easy)
+	case 5:
+		this.Action = NewPopulatedLictProposal_SetQueryPolicy(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedLictProposal_Mint(r randyTenderlic, easy bool) *LictProposal_Mint {
+	this := &LictProposal_Mint{}
+	this.Mint = NewPopulatedLictMint(r, easy)
+	return this
+}
+func NewPopulatedLictProposal_SetAllowedMeters(r randyTenderlic, easy bool) *LictProposal_SetAllowedMeters {
+	this := &LictProposal_SetAllowedMeters{}
+	this.SetAllowedMeters = NewPopulatedLictSetAllowedMeters(r, easy)
+	return this
+}
+func NewPopulatedLictProposal_SetAdmins(r randyTenderlic, easy bool) *LictProposal_SetAdmins {
+	this := &LictProposal_SetAdmins{}
+	this.SetAdmins = NewPopulatedLictSetAdmins(r, easy)
+	return this
+}
+func NewPopulatedLictProposal_SetValidator(r randyTenderlic, easy bool) *LictProposal_SetValidator {
+	this := &LictProposal_SetValidator{}
+	this.SetValidator = NewPopulatedLictSetValidator(r, easy)
+	return this
+}
+func NewPopulatedLictProposal_SetQueryPolicy(r randyTenderlic, easy bool) *LictProposal_SetQueryPolicy {
+	this := &LictProposal_SetQueryPolicy{}
+	this.SetQueryPolicy = NewPopulatedLictSetQueryPolicy(r, easy)
+	return this
+}
+func NewPopulatedLictPropose(r randyTenderlic, easy bool) *LictPropose {
+	this := &LictPropose{}
+	if r.Intn(5) != 0 {
+		this.Proposal = NewPopulatedLictProposal(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedLictApprove(r randyTenderlic, easy bool) *LictApprove {
+	this := &LictApprove{}
+	this.ProposalId = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedLictExecute(r randyTenderlic, easy bool) *LictExecute {
+	this := &LictExecute{}
+	this.ProposalId = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedTenderlic(r, 2)
+	}
+	return this
+}
+
+type randyTenderlic interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneTenderlic(r randyTenderlic) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringTenderlic(r randyTenderlic) string {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
+		tmps[i] = randUTF8RuneTenderlic(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedTenderlic(r randyTenderlic, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldTenderlic(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldTenderlic(dAtA []byte, r randyTenderlic, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateTenderlic(dAtA, uint64(key))
+		v5 := r.Int63()
+		if r.Intn(2) == 0 {
+			v5 *= -1
+		}
+		dAtA = encodeVarintPopulateTenderlic(dAtA, uint64(v5))
+	case 1:
+		dAtA = encodeVarintPopulateTenderlic(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateTenderlic(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateTenderlic(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateTenderlic(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateTenderlic(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *LictTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTenderlic(uint64(m.Version))
+	}
+	if m.Msg != nil {
+		n += m.Msg.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictTx_Transfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		l = m.Transfer.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	return n
+}
+func (m *LictTx_Burn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Burn != nil {
+		l = m.Burn.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	return n
+}
+func (m *LictTx_PowerMeasure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PowerMeasure != nil {
+		l = m.PowerMeasure.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	return n
+}
+func (m *LictTx_Propose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Propose != nil {
+		l = m.Propose.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	return n
+}
+func (m *LictTx_Approve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Approve != nil {
+		l = m.Approve.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	return n
+}
+func (m *LictTx_Execute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Execute != nil {
+		l = m.Execute.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	return n
+}
+func (m *LictMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Meter)
+	if l > 0 {
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTenderlic(uint64(m.Amount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTenderlic(uint64(m.Amount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovTenderlic(uint64(m.Amount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictSetAdmins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovTenderlic(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTenderlic(uint64(m.Threshold))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictSetAllowedMeters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for _, b := range m.PubKeys {
+			l = len(b)
+			n += 1 + l + sovTenderlic(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictSetValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovTenderlic(uint64(m.Power))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictSetQueryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictPowerMeasure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovTenderlic(uint64(m.Timestamp))
+	}
+	if m.Value != 0 {
+		n += 1 + sovTenderlic(uint64(m.Value))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != nil {
+		n += m.Action.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictProposal_Mint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mint != nil {
+		l = m.Mint.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	return n
+}
+func (m *LictProposal_SetAllowedMeters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetAllowedMeters != nil {
+		l = m.SetAllowedMeters.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	return n
+}
+func (m *LictProposal_SetAdmins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetAdmins != nil {
+		l = m.SetAdmins.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	return n
+}
+func (m *LictProposal_SetValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetValidator != nil {
+		l = m.SetValidator.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	return n
+}
+func (m *LictProposal_SetQueryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetQueryPolicy != nil {
+		l = m.SetQueryPolicy.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	return n
+}
+func (m *LictPropose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovTenderlic(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictApprove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTenderlic(uint64(m.ProposalId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LictExecute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTenderlic(uint64(m.ProposalId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTenderlic(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTenderlic(x uint64) (n int) {
+	return sovTenderlic(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LictTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LictTransfer{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &LictTx_Transfer{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LictBurn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &LictTx_Burn{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerMeasure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LictPowerMeasure{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &LictTx_PowerMeasure{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Propose", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LictPropose{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &LictTx_Propose{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LictApprove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &LictTx_Approve{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LictExecute{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Msg = &LictTx_Execute{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictSetAdmins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictSetAdmins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictSetAdmins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictSetAllowedMeters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictSetAllowedMeters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictSetAllowedMeters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PubKeys[len(m.PubKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictSetValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictSetValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictSetValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictSetQueryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictSetQueryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictSetQueryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictPowerMeasure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictPowerMeasure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictPowerMeasure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LictMint{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &LictProposal_Mint{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAllowedMeters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LictSetAllowedMeters{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &LictProposal_SetAllowedMeters{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAdmins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LictSetAdmins{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &LictProposal_SetAdmins{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LictSetValidator{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &LictProposal_SetValidator{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetQueryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LictSetQueryPolicy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &LictProposal_SetQueryPolicy{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictPropose) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictPropose: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictPropose: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposal == nil {
+				m.Proposal = &LictProposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictApprove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictApprove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictApprove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LictExecute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LictExecute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LictExecute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenderlic(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTenderlic
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTenderlic(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTenderlic
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTenderlic
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTenderlic
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTenderlic
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTenderlic
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTenderlic        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTenderlic          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTenderlic = fmt.Errorf("proto: unexpected end of group")
+)
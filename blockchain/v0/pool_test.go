@@ -20,6 +20,7 @@ func init() {
 
 type testPeer struct {
 	id        p2p.ID
+	base      int64
 	height    int64
 	inputChan chan inputData //make sure each peer's data is sequential
 }
@@ -67,7 +68,7 @@ func makePeers(numPeers int, minHeight, maxHeight int64) testPeers {
 	for i := 0; i < numPeers; i++ {
 		peerID := p2p.ID(tmrand.Str(12))
 		height := minHeight + tmrand.Int63n(maxHeight-minHeight)
-		peers[peerID] = testPeer{peerID, height, make(chan inputData, 10)}
+		peers[peerID] = testPeer{peerID, 1, height, make(chan inputData, 10)}
 	}
 	return peers
 }
@@ -93,7 +94,7 @@ func TestBlockPoolBasic(t *testing.T) {
 	// Introduce each peer.
 	go func() {
 		for _, peer := range peers {
-			pool.SetPeerHeight(peer.id, peer.height)
+			pool.SetPeerRange(peer.id, peer.base, peer.height)
 		}
 	}()
 
@@ -148,7 +149,7 @@ func TestBlockPoolTimeout(t *testing.T) {
 	// Introduce each peer.
 	go func() {
 		for _, peer := range peers {
-			pool.SetPeerHeight(peer.id, peer.height)
+			pool.SetPeerRange(peer.id, peer.base, peer.height)
 		}
 	}()
 
@@ -192,7 +193,7 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		peerID := p2p.ID(fmt.Sprintf("%d", i+1))
 		height := int64(i + 1)
-		peers[peerID] = testPeer{peerID, height, make(chan inputData)}
+		peers[peerID] = testPeer{peerID, 1, height, make(chan inputData)}
 	}
 	requestsCh := make(chan BlockRequest)
 	errorsCh := make(chan peerError)
@@ -205,7 +206,7 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 
 	// add peers
 	for peerID, peer := range peers {
-		pool.SetPeerHeight(peerID, peer.height)
+		pool.SetPeerRange(peerID, peer.base, peer.height)
 	}
 	assert.EqualValues(t, 10, pool.MaxPeerHeight())
 
@@ -223,3 +224,22 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 
 	assert.EqualValues(t, 0, pool.MaxPeerHeight())
 }
+
+func TestBlockPoolPickPeerInRange(t *testing.T) {
+	pool := NewBlockPool(1, make(chan BlockRequest), make(chan peerError))
+	pool.SetLogger(log.TestingLogger())
+
+	// the peer pruned the blocks below 5
+	pool.SetPeerRange(p2p.ID("pruned"), 5, 10)
+	assert.Nil(t, pool.pickIncrAvailablePeer(4))
+	assert.Nil(t, pool.pickIncrAvailablePeer(11))
+	if peer := pool.pickIncrAvailablePeer(5); assert.NotNil(t, peer) {
+		assert.EqualValues(t, "pruned", peer.id)
+	}
+
+	// a peer which kept all its blocks
+	pool.SetPeerRange(p2p.ID("archive"), 1, 10)
+	if peer := pool.pickIncrAvailablePeer(4); assert.NotNil(t, peer) {
+		assert.EqualValues(t, "archive", peer.id)
+	}
+}
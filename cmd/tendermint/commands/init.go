@@ -55,10 +55,15 @@ func initFilesWithConfig(config *cfg.Config) error {
 	if tmos.FileExists(genFile) {
 		logger.Info("Found genesis file", "path", genFile)
 	} else {
+		appState, err := tenderlicAppState()
+		if err != nil {
+			return err
+		}
 		genDoc := types.GenesisDoc{
 			ChainID:         fmt.Sprintf("test-chain-%v", tmrand.Str(6)),
 			GenesisTime:     tmtime.Now(),
 			ConsensusParams: types.DefaultConsensusParams(),
+			AppState:        appState,
 		}
 		key := pv.GetPubKey()
 		genDoc.Validators = []types.GenesisValidator{{
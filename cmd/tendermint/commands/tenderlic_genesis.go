@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/abci/example/tenderlic_kvstore"
+)
+
+// app_state of the tenderlic_kvstore application
+var (
+	tlAdmins            []string
+	tlThreshold         uint32
+	tlMeterPubKeys      []string
+	tlBalances          []string
+	tlMaxValidatorPower int64
+)
+
+func init() {
+	addTenderlicGenesisFlags(InitFilesCmd)
+	addTenderlicGenesisFlags(TestnetFilesCmd)
+}
+
+func addTenderlicGenesisFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&tlAdmins, "tenderlic-admin", []string{},
+		"Address of a tenderlic_kvstore community admin (use --tenderlic-admin multiple times for multiple admins)")
+	cmd.Flags().Uint32Var(&tlThreshold, "tenderlic-threshold", 0,
+		"Number of admin approvals required to execute a proposal (1 if 0 and admins are set)")
+	cmd.Flags().StringArrayVar(&tlMeterPubKeys, "tenderlic-meter-pubkey", []string{},
+		"Public key of an allowed meter, as base64 ed25519 key or amino JSON"+
+			" (use --tenderlic-meter-pubkey multiple times for multiple meters)")
+	cmd.Flags().StringArrayVar(&tlBalances, "tenderlic-balance", []string{},
+		"Starting LICT balance of an allowed meter, as <address>=<amount>"+
+			" (use --tenderlic-balance multiple times for multiple meters)")
+	cmd.Flags().Int64Var(&tlMaxValidatorPower, "tenderlic-max-validator-power", 0,
+		"Maximum voting power of a validator (0 for the application default)")
+}
+
+// tenderlicAppState returns the genesis app_state of the tenderlic_kvstore
+// flags, or nil if no flag is set.
+func tenderlicAppState() (json.RawMessage, error) {
+	if len(tlAdmins) == 0 && tlThreshold == 0 && len(tlMeterPubKeys) == 0 &&
+		len(tlBalances) == 0 && tlMaxValidatorPower == 0 {
+		return nil, nil
+	}
+
+	gs := tenderlic_kvstore.GenesisState{
+		Admins:            tlAdmins,
+		Threshold:         tlThreshold,
+		MaxValidatorPower: tlMaxValidatorPower,
+	}
+	if len(gs.Admins) > 0 && gs.Threshold == 0 {
+		gs.Threshold = 1
+	}
+	for _, s := range tlMeterPubKeys {
+		pubKey, err := tenderlic_kvstore.ParseMeterPubKey(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid meter pubkey %q: %v", s, err)
+		}
+		gs.Meters = append(gs.Meters, tenderlic_kvstore.GenesisMeter{PubKey: pubKey})
+	}
+	for _, balance := range tlBalances {
+		parts := strings.Split(balance, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("expected <address>=<amount>, got %q", balance)
+		}
+		amount, err := tenderlic_kvstore.ParseAmount(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		gs.Balances = append(gs.Balances, tenderlic_kvstore.GenesisBalance{Account: parts[0], Amount: amount})
+	}
+
+	if err := gs.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid tenderlic_kvstore app_state: %v", err)
+	}
+	return gs.MarshalAppState()
+}
@@ -101,6 +101,11 @@ func testnetFiles(cmd *cobra.Command, args []string) error {
 
 	config := cfg.DefaultConfig()
 
+	appState, err := tenderlicAppState()
+	if err != nil {
+		return err
+	}
+
 	// overwrite default config if set and valid
 	if configFile != "" {
 		viper.SetConfigFile(configFile)
@@ -172,6 +177,7 @@ func testnetFiles(cmd *cobra.Command, args []string) error {
 		ConsensusParams: types.DefaultConsensusParams(),
 		GenesisTime:     tmtime.Now(),
 		Validators:      genVals,
+		AppState:        appState,
 	}
 
 	// Write genesis file.
@@ -184,10 +190,7 @@ func testnetFiles(cmd *cobra.Command, args []string) error {
 	}
 
 	// Gather persistent peer addresses.
-	var (
-		persistentPeers string
-		err             error
-	)
+	var persistentPeers string
 	if populatePersistentPeers {
 		persistentPeers, err = persistentPeersString(config)
 		if err != nil {
@@ -113,6 +113,10 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		"MaxTxsBytes",
 		"CacheSize",
 		"MaxTxBytes",
+		"TTLNumBlocks",
+		"TTLDuration",
+		"MaxTxsPerPeer",
+		"MaxTxsPerSender",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -120,6 +124,42 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	// tamper with version
+	cfg.Version = "v1"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.Version = "invalid"
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestStateSyncConfigValidateBasic(t *testing.T) {
+	cfg := TestStateSyncConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.Enable = true
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.RPCServers = []string{"127.0.0.1:26657", "127.0.0.2:26657"}
+	cfg.TrustHeight = 10
+	cfg.TrustHash = "A6A8D9AB3C0D4B8C3DCEA7C2E1C8B5F4B1FAB59A1FA4AF3C2BC1B4E8A4F7C0D2"
+	assert.NoError(t, cfg.ValidateBasic())
+
+	testCases := map[string]func(*StateSyncConfig){
+		"one rpc server":      func(c *StateSyncConfig) { c.RPCServers = c.RPCServers[:1] },
+		"empty rpc server":    func(c *StateSyncConfig) { c.RPCServers = []string{"127.0.0.1:26657", ""} },
+		"no trust height":     func(c *StateSyncConfig) { c.TrustHeight = 0 },
+		"invalid trust hash":  func(c *StateSyncConfig) { c.TrustHash = "xyz" },
+		"no trust period":     func(c *StateSyncConfig) { c.TrustPeriod = 0 },
+		"short discovery":     func(c *StateSyncConfig) { c.DiscoveryTime = time.Second },
+		"short chunk timeout": func(c *StateSyncConfig) { c.ChunkRequestTimeout = time.Millisecond },
+		"no chunk fetchers":   func(c *StateSyncConfig) { c.ChunkFetchers = 0 },
+	}
+	for desc, tamper := range testCases {
+		c := *cfg
+		tamper(&c)
+		assert.Error(t, c.ValidateBasic(), desc)
+	}
 }
 
 func TestFastSyncConfigValidateBasic(t *testing.T) {
@@ -158,6 +198,30 @@ func TestConsensusConfigValidateBasic(t *testing.T) {
 	}
 }
 
+func TestTxIndexConfigValidateBasic(t *testing.T) {
+	cfg := TestTxIndexConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.Equal(t, []string{"kv"}, cfg.Indexers())
+
+	cfg.Indexer = "kv, sql"
+	cfg.SQLConn = "tx_index.sqlite"
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.Equal(t, []string{"kv", "sql"}, cfg.Indexers())
+
+	testCases := map[string]func(*TxIndexConfig){
+		"unknown indexer":   func(c *TxIndexConfig) { c.Indexer = "foo" },
+		"duplicate indexer": func(c *TxIndexConfig) { c.Indexer = "kv,kv" },
+		"combined null":     func(c *TxIndexConfig) { c.Indexer = "null,kv" },
+		"unknown driver":    func(c *TxIndexConfig) { c.SQLDriver = "foo" },
+		"no sql conn":       func(c *TxIndexConfig) { c.SQLConn = "" },
+	}
+	for desc, tamper := range testCases {
+		c := *cfg
+		tamper(&c)
+		assert.Error(t, c.ValidateBasic(), desc)
+	}
+}
+
 func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	cfg := TestInstrumentationConfig()
 	assert.NoError(t, cfg.ValidateBasic())
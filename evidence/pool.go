@@ -5,6 +5,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	clist "github.com/tendermint/tendermint/libs/clist"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
@@ -23,16 +25,19 @@ type Pool struct {
 
 	// needed to load validators to verify evidence
 	stateDB dbm.DB
+	// needed to load headers to verify composite evidence
+	blockStore sm.BlockStoreRPC
 
 	// latest state
 	mtx   sync.Mutex
 	state sm.State
 }
 
-func NewPool(stateDB, evidenceDB dbm.DB) *Pool {
+func NewPool(stateDB, evidenceDB dbm.DB, blockStore sm.BlockStoreRPC) *Pool {
 	store := NewStore(evidenceDB)
 	evpool := &Pool{
 		stateDB:      stateDB,
+		blockStore:   blockStore,
 		state:        sm.LoadState(stateDB),
 		logger:       log.NewNopLogger(),
 		store:        store,
@@ -94,8 +99,46 @@ func (evpool *Pool) Update(block *types.Block, state sm.State) {
 	evpool.MarkEvidenceAsCommitted(block.Height, block.Time, block.Evidence.Evidence)
 }
 
-// AddEvidence checks the evidence is valid and adds it to the pool.
-func (evpool *Pool) AddEvidence(evidence types.Evidence) (err error) {
+// AddEvidence checks the evidence is valid and adds it to the pool. Composite
+// evidence is first verified against the committed header at the same height,
+// then split into individual pieces of evidence, which are added one by one.
+func (evpool *Pool) AddEvidence(evidence types.Evidence) error {
+	ce, ok := evidence.(types.CompositeEvidence)
+	if !ok {
+		return evpool.addEvidence(evidence)
+	}
+
+	if err := evidence.ValidateBasic(); err != nil {
+		return err
+	}
+	if evpool.blockStore == nil {
+		return errors.New("can't verify composite evidence without a block store")
+	}
+	blockMeta := evpool.blockStore.LoadBlockMeta(evidence.Height())
+	if blockMeta == nil {
+		return fmt.Errorf("don't have block meta at height #%d", evidence.Height())
+	}
+	valSet, err := sm.LoadValidators(evpool.stateDB, evidence.Height())
+	if err != nil {
+		return err
+	}
+	if err := ce.VerifyComposite(&blockMeta.Header, valSet); err != nil {
+		return err
+	}
+
+	evList := ce.Split(&blockMeta.Header, valSet)
+	if len(evList) == 0 {
+		return errors.New("no validator signed both headers in the same round")
+	}
+	for _, ev := range evList {
+		if err := evpool.addEvidence(ev); err != nil {
+			return errors.Wrapf(err, "failed to add %v", ev)
+		}
+	}
+	return nil
+}
+
+func (evpool *Pool) addEvidence(evidence types.Evidence) (err error) {
 
 	// TODO: check if we already have evidence for this
 	// validator at this height so we dont get spammed
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
@@ -60,7 +61,7 @@ func TestEvidencePool(t *testing.T) {
 		height       = int64(5)
 		stateDB      = initializeValidatorState(valAddr, height)
 		evidenceDB   = dbm.NewMemDB()
-		pool         = NewPool(stateDB, evidenceDB)
+		pool         = NewPool(stateDB, evidenceDB, nil)
 		evidenceTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
 	)
 
@@ -99,7 +100,7 @@ func TestEvidencePoolIsCommitted(t *testing.T) {
 		lastBlockTime = time.Now()
 		stateDB       = initializeValidatorState(valAddr, height)
 		evidenceDB    = dbm.NewMemDB()
-		pool          = NewPool(stateDB, evidenceDB)
+		pool          = NewPool(stateDB, evidenceDB, nil)
 	)
 
 	// evidence not seen yet:
@@ -122,7 +123,7 @@ func TestAddEvidence(t *testing.T) {
 		height       = int64(100002)
 		stateDB      = initializeValidatorState(valAddr, height)
 		evidenceDB   = dbm.NewMemDB()
-		pool         = NewPool(stateDB, evidenceDB)
+		pool         = NewPool(stateDB, evidenceDB, nil)
 		evidenceTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
 	)
 
@@ -148,3 +149,77 @@ func TestAddEvidence(t *testing.T) {
 		}
 	}
 }
+
+type mockBlockStore struct {
+	sm.BlockStoreRPC
+	metas map[int64]*types.BlockMeta
+}
+
+func (bs mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	return bs.metas[height]
+}
+
+func makeSignedHeader(t *testing.T, height int64, appHash []byte,
+	valSet *types.ValidatorSet, privVals []types.PrivValidator) *types.SignedHeader {
+
+	header := &types.Header{
+		ChainID:        "test_chain_id",
+		Height:         height,
+		Time:           tmtime.Now(),
+		ValidatorsHash: valSet.Hash(),
+		AppHash:        appHash,
+	}
+	blockID := types.BlockID{
+		Hash:        header.Hash(),
+		PartsHeader: types.PartSetHeader{Total: 1, Hash: []byte("parts_hash_parts_hash_parts_hash")},
+	}
+	voteSet := types.NewVoteSet(header.ChainID, height, 0, types.PrecommitType, valSet)
+	commit, err := types.MakeCommit(blockID, height, 0, voteSet, privVals)
+	require.NoError(t, err)
+	return &types.SignedHeader{Header: header, Commit: commit}
+}
+
+func TestAddCompositeEvidence(t *testing.T) {
+	var (
+		height            = int64(5)
+		valSet, privVals  = types.RandValidatorSet(3, 10)
+		stateDB           = dbm.NewMemDB()
+		committedHeader   = makeSignedHeader(t, height, []byte("app_hash"), valSet, privVals)
+		alternativeHeader = makeSignedHeader(t, height, []byte("alt_app_hash"), valSet, privVals)
+		blockStore        = mockBlockStore{metas: map[int64]*types.BlockMeta{
+			height: {Header: *committedHeader.Header},
+		}}
+	)
+	state := sm.State{
+		ChainID:                     "test_chain_id",
+		LastBlockTime:               tmtime.Now(),
+		Validators:                  valSet,
+		NextValidators:              valSet.CopyIncrementProposerPriority(1),
+		LastHeightValidatorsChanged: 1,
+		ConsensusParams: types.ConsensusParams{
+			Evidence: types.EvidenceParams{
+				MaxAgeNumBlocks: 10000,
+				MaxAgeDuration:  48 * time.Hour,
+			},
+		},
+	}
+	for i := int64(0); i <= height; i++ {
+		state.LastBlockHeight = i
+		sm.SaveState(stateDB, state)
+	}
+	pool := NewPool(stateDB, dbm.NewMemDB(), blockStore)
+
+	// none of the headers is committed
+	otherHeader := makeSignedHeader(t, height, []byte("other_app_hash"), valSet, privVals)
+	err := pool.AddEvidence(types.NewConflictingHeadersEvidence(alternativeHeader, otherHeader))
+	assert.Error(t, err)
+	assert.Equal(t, 0, pool.evidenceList.Len())
+
+	// every validator gets a duplicate vote evidence
+	err = pool.AddEvidence(types.NewConflictingHeadersEvidence(committedHeader, alternativeHeader))
+	require.NoError(t, err)
+	assert.Equal(t, 3, pool.evidenceList.Len())
+	for _, ev := range pool.PendingEvidence(-1) {
+		assert.IsType(t, &types.DuplicateVoteEvidence{}, ev)
+	}
+}
@@ -0,0 +1,71 @@
+// Package sqldialect describes the differences between the SQL databases
+// supported through database/sql (by the sql tx indexer and the light client
+// sql store).
+package sqldialect
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Dialect describes the differences between the supported databases.
+type Dialect struct {
+	// column type of binary data
+	BlobType string
+	// column type of auto-incremented primary keys
+	IDType string
+	// function returning the position of a substring, or 0 if it is missing
+	ContainsFunc string
+	// placeholders are numbered ($1, $2, ...) instead of ?
+	NumberedPlaceholders bool
+	// inserted ids are returned through RETURNING instead of LastInsertId
+	ReturningID bool
+}
+
+// dialects are the supported databases, by the name of their database/sql
+// driver.
+var dialects = map[string]Dialect{
+	"sqlite3": {
+		BlobType:     "BLOB",
+		IDType:       "INTEGER PRIMARY KEY",
+		ContainsFunc: "instr",
+	},
+	"postgres": {
+		BlobType:             "BYTEA",
+		IDType:               "BIGSERIAL PRIMARY KEY",
+		ContainsFunc:         "strpos",
+		NumberedPlaceholders: true,
+		ReturningID:          true,
+	},
+}
+
+// ForDriver returns the dialect of the given database/sql driver ("sqlite3"
+// or "postgres").
+func ForDriver(driverName string) (Dialect, error) {
+	d, ok := dialects[driverName]
+	if !ok {
+		return Dialect{}, fmt.Errorf("unsupported SQL driver %q", driverName)
+	}
+	return d, nil
+}
+
+// Rebind replaces the ? placeholders of the query by the ones of the dialect.
+func (d Dialect) Rebind(query string) string {
+	if !d.NumberedPlaceholders {
+		return query
+	}
+	var (
+		sb strings.Builder
+		n  int
+	)
+	for _, r := range query {
+		if r == '?' {
+			n++
+			sb.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
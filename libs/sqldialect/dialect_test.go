@@ -0,0 +1,29 @@
+package sqldialect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForDriver(t *testing.T) {
+	for _, driverName := range []string{"sqlite3", "postgres"} {
+		_, err := ForDriver(driverName)
+		assert.NoError(t, err, driverName)
+	}
+
+	_, err := ForDriver("mysql")
+	assert.Error(t, err)
+}
+
+func TestRebind(t *testing.T) {
+	d, err := ForDriver("postgres")
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT a FROM b WHERE c = $1 AND d IN ($2, $3)",
+		d.Rebind("SELECT a FROM b WHERE c = ? AND d IN (?, ?)"))
+
+	d, err = ForDriver("sqlite3")
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT a FROM b WHERE c = ?", d.Rebind("SELECT a FROM b WHERE c = ?"))
+}
@@ -10,7 +10,6 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 	tmmath "github.com/tendermint/tendermint/libs/math"
-	tmrand "github.com/tendermint/tendermint/libs/rand"
 	"github.com/tendermint/tendermint/lite2/provider"
 	"github.com/tendermint/tendermint/lite2/store"
 	"github.com/tendermint/tendermint/types"
@@ -51,6 +50,7 @@ const (
 
 	defaultUpdatePeriod                       = 5 * time.Second
 	defaultRemoveNoLongerTrustedHeadersPeriod = 24 * time.Hour
+	defaultPruningSize                        = 0
 )
 
 // Option sets a parameter for the light client.
@@ -84,8 +84,10 @@ func SkippingVerification(trustLevel tmmath.Fraction) Option {
 	}
 }
 
-// AlternativeSources option can be used to supply alternative providers, which
-// will be used for cross-checking the primary provider.
+// AlternativeSources option can be used to supply alternative providers
+// (witnesses), which will be used for cross-checking the primary provider.
+// Every header verified by the client (including the intermediate ones) is
+// compared with the one from each witness. See VerifyHeader.
 func AlternativeSources(providers []provider.Provider) Option {
 	return func(c *Client) {
 		c.alternatives = providers
@@ -109,6 +111,16 @@ func RemoveNoLongerTrustedHeadersPeriod(d time.Duration) Option {
 	}
 }
 
+// PruningSize option sets the maximum number of headers (and validator sets)
+// kept in the trusted store. Once a new header is verified, the store is
+// pruned according to its eviction policy (see store.Store#Prune). Default:
+// 0, the store is not pruned.
+func PruningSize(size int) Option {
+	return func(c *Client) {
+		c.pruningSize = size
+	}
+}
+
 // ConfirmationFunction option can be used to prompt to confirm an action. For
 // example, remove newer headers if the light client is being reset with an
 // older header. No confirmation is required by default!
@@ -143,7 +155,7 @@ type Client struct {
 	primary provider.Provider
 
 	// Alternative providers for checking the primary for misbehavior by
-	// comparing data.
+	// comparing data. Faulty ones are removed.
 	alternatives []provider.Provider
 
 	// Where trusted headers are stored.
@@ -152,6 +164,8 @@ type Client struct {
 	trustedHeader *types.SignedHeader
 	// Highest next validator set from the store (height=H+1).
 	trustedNextVals *types.ValidatorSet
+	// Maximum number of headers in the store (0 - unlimited).
+	pruningSize int
 
 	updatePeriod                       time.Duration
 	removeNoLongerTrustedHeadersPeriod time.Duration
@@ -185,6 +199,7 @@ func NewClient(
 		trustedStore:                       trustedStore,
 		updatePeriod:                       defaultUpdatePeriod,
 		removeNoLongerTrustedHeadersPeriod: defaultRemoveNoLongerTrustedHeadersPeriod,
+		pruningSize:                        defaultPruningSize,
 		confirmationFn:                     func(action string) bool { return true },
 		quit:                               make(chan struct{}),
 		logger:                             log.NewNopLogger(),
@@ -441,13 +456,22 @@ func (c *Client) ChainID() string {
 // VerifyHeaderAtHeight fetches the header and validators at the given height
 // and calls VerifyHeader.
 //
-// If the trusted header is more recent than one here, an error is returned.
+// If the trusted header is more recent than one here (but the header is not
+// older than the first trusted header), an error is returned.
 // If the header is not found by the primary provider,
 // provider.ErrSignedHeaderNotFound error is returned.
 func (c *Client) VerifyHeaderAtHeight(height int64, now time.Time) (*types.SignedHeader, error) {
 	c.logger.Info("VerifyHeaderAtHeight", "height", height)
 
-	if c.trustedHeader.Height >= height {
+	if height <= 0 {
+		return nil, errors.New("negative or zero height")
+	}
+
+	firstHeight, err := c.FirstTrustedHeight()
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get first trusted height")
+	}
+	if height >= firstHeight && c.trustedHeader.Height >= height {
 		return nil, errors.Errorf("header at more recent height #%d exists", c.trustedHeader.Height)
 	}
 
@@ -472,30 +496,48 @@ func (c *Client) VerifyHeaderAtHeight(height int64, now time.Time) (*types.Signe
 // intermediate headers will be requested. See the specification for details.
 // https://github.com/tendermint/spec/blob/master/spec/consensus/light-client.md
 //
-// If the trusted header is more recent than one here, an error is returned.
+// If the new header is older than the first trusted header, backwards
+// verification is performed instead: starting from the first trusted header,
+// all the preceding headers are requested and verified by following the
+// LastBlockID hash links down to the new header. Every verified header is
+// saved to the trusted store. Note they are still removed once they fall out
+// of the trusting period (see RemoveNoLongerTrustedHeadersPeriod).
+//
+// If the trusted header is more recent than one here (but the new header is
+// not older than the first trusted header), an error is returned.
 //
 // If, at any moment, SignedHeader or ValidatorSet are not found by the primary
 // provider, provider.ErrSignedHeaderNotFound /
 // provider.ErrValidatorSetNotFound error is returned.
+//
+// Once verified, every header on the verification path (including the new
+// one) is cross-checked with the witnesses (see AlternativeSources). If a
+// witness has a conflicting header, which is signed by {trustLevel} of the
+// trusted validator set, ConflictingHeadersEvidence is reported to the
+// primary and all the witnesses, the headers from the diverged height onwards
+// are removed from the trusted store and ErrConflictingHeaders is returned.
+// Witnesses with invalid headers are removed.
 func (c *Client) VerifyHeader(newHeader *types.SignedHeader, newVals *types.ValidatorSet, now time.Time) error {
 	c.logger.Info("VerifyHeader", "height", newHeader.Hash(), "newVals", fmt.Sprintf("%X", newVals.Hash()))
 
-	if c.trustedHeader.Height >= newHeader.Height {
-		return errors.Errorf("header at more recent height #%d exists", c.trustedHeader.Height)
+	firstHeight, err := c.FirstTrustedHeight()
+	if err != nil {
+		return errors.Wrap(err, "can't get first trusted height")
+	}
+	if newHeader.Height < firstHeight {
+		return c.backwards(firstHeight, newHeader, newVals, now)
 	}
 
-	if len(c.alternatives) > 0 {
-		if err := c.compareNewHeaderWithRandomAlternative(newHeader); err != nil {
-			return err
-		}
+	if c.trustedHeader.Height >= newHeader.Height {
+		return errors.Errorf("header at more recent height #%d exists", c.trustedHeader.Height)
 	}
 
-	var err error
+	var trace []tracedHeader
 	switch c.verificationMode {
 	case sequential:
-		err = c.sequence(newHeader, newVals, now)
+		trace, err = c.sequence(newHeader, newVals, now)
 	case skipping:
-		err = c.bisection(c.trustedHeader, c.trustedNextVals, newHeader, newVals, now)
+		trace, err = c.bisection(c.trustedHeader, c.trustedNextVals, newHeader, newVals, now)
 	default:
 		panic(fmt.Sprintf("Unknown verification mode: %b", c.verificationMode))
 	}
@@ -503,12 +545,20 @@ func (c *Client) VerifyHeader(newHeader *types.SignedHeader, newVals *types.Vali
 		return err
 	}
 
+	if err := c.detectDivergence(trace, now); err != nil {
+		return err
+	}
+
 	// Update trusted header and vals.
 	nextVals, err := c.primary.ValidatorSet(newHeader.Height + 1)
 	if err != nil {
 		return err
 	}
-	return c.updateTrustedHeaderAndVals(newHeader, nextVals)
+	if err := c.updateTrustedHeaderAndVals(newHeader, nextVals); err != nil {
+		return err
+	}
+
+	return c.pruneTrustedStore()
 }
 
 // Cleanup removes all the data (headers and validator sets) stored. It blocks
@@ -553,9 +603,17 @@ func (c *Client) cleanup(stopHeight int64) error {
 }
 
 // see VerifyHeader
-func (c *Client) sequence(newHeader *types.SignedHeader, newVals *types.ValidatorSet, now time.Time) error {
+//
+// Returns the verification trace: the trusted header, all the intermediate
+// headers and the new header.
+func (c *Client) sequence(
+	newHeader *types.SignedHeader,
+	newVals *types.ValidatorSet,
+	now time.Time) ([]tracedHeader, error) {
+
 	// 1) Verify any intermediate headers.
 	var (
+		trace         = []tracedHeader{{c.trustedHeader, c.trustedNextVals}}
 		interimHeader *types.SignedHeader
 		nextVals      *types.ValidatorSet
 		err           error
@@ -563,7 +621,7 @@ func (c *Client) sequence(newHeader *types.SignedHeader, newVals *types.Validato
 	for height := c.trustedHeader.Height + 1; height < newHeader.Height; height++ {
 		interimHeader, err = c.primary.SignedHeader(height)
 		if err != nil {
-			return errors.Wrapf(err, "failed to obtain the header #%d", height)
+			return nil, errors.Wrapf(err, "failed to obtain the header #%d", height)
 		}
 
 		c.logger.Debug("Verify newHeader against lastHeader",
@@ -574,7 +632,7 @@ func (c *Client) sequence(newHeader *types.SignedHeader, newVals *types.Validato
 		err = Verify(c.chainID, c.trustedHeader, c.trustedNextVals, interimHeader, c.trustedNextVals,
 			c.trustingPeriod, now, c.trustLevel)
 		if err != nil {
-			return errors.Wrapf(err, "failed to verify the header #%d", height)
+			return nil, errors.Wrapf(err, "failed to verify the header #%d", height)
 		}
 
 		// Update trusted header and vals.
@@ -583,26 +641,33 @@ func (c *Client) sequence(newHeader *types.SignedHeader, newVals *types.Validato
 		} else {
 			nextVals, err = c.primary.ValidatorSet(height + 1)
 			if err != nil {
-				return errors.Wrapf(err, "failed to obtain the vals #%d", height+1)
+				return nil, errors.Wrapf(err, "failed to obtain the vals #%d", height+1)
 			}
 		}
 		err = c.updateTrustedHeaderAndVals(interimHeader, nextVals)
 		if err != nil {
-			return errors.Wrapf(err, "failed to update trusted state #%d", height)
+			return nil, errors.Wrapf(err, "failed to update trusted state #%d", height)
 		}
+		trace = append(trace, tracedHeader{interimHeader, nextVals})
 	}
 
 	// 2) Verify the new header.
-	return Verify(c.chainID, c.trustedHeader, c.trustedNextVals, newHeader, newVals, c.trustingPeriod, now, c.trustLevel)
+	err = Verify(c.chainID, c.trustedHeader, c.trustedNextVals, newHeader, newVals, c.trustingPeriod, now, c.trustLevel)
+	if err != nil {
+		return nil, err
+	}
+	return append(trace, tracedHeader{newHeader, nil}), nil
 }
 
 // see VerifyHeader
+//
+// Returns the verification trace: lastHeader, the pivot headers and newHeader.
 func (c *Client) bisection(
 	lastHeader *types.SignedHeader,
 	lastVals *types.ValidatorSet,
 	newHeader *types.SignedHeader,
 	newVals *types.ValidatorSet,
-	now time.Time) error {
+	now time.Time) ([]tracedHeader, error) {
 
 	c.logger.Debug("Verify newHeader against lastHeader",
 		"lastHeight", lastHeader.Height,
@@ -612,35 +677,33 @@ func (c *Client) bisection(
 	err := Verify(c.chainID, lastHeader, lastVals, newHeader, newVals, c.trustingPeriod, now, c.trustLevel)
 	switch err.(type) {
 	case nil:
-		return nil
+		return []tracedHeader{{lastHeader, lastVals}, {newHeader, nil}}, nil
 	case ErrNewValSetCantBeTrusted:
 		// continue bisection
 	default:
-		return errors.Wrapf(err, "failed to verify the header #%d", newHeader.Height)
+		return nil, errors.Wrapf(err, "failed to verify the header #%d", newHeader.Height)
 	}
 
 	pivot := (c.trustedHeader.Height + newHeader.Header.Height) / 2
 	pivotHeader, pivotVals, err := c.fetchHeaderAndValsAtHeight(pivot)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// left branch
-	{
-		err := c.bisection(lastHeader, lastVals, pivotHeader, pivotVals, now)
-		if err != nil {
-			return errors.Wrapf(err, "bisection of #%d and #%d", lastHeader.Height, pivot)
-		}
+	trace, err := c.bisection(lastHeader, lastVals, pivotHeader, pivotVals, now)
+	if err != nil {
+		return nil, errors.Wrapf(err, "bisection of #%d and #%d", lastHeader.Height, pivot)
 	}
 
 	// right branch
 	{
 		nextVals, err := c.primary.ValidatorSet(pivot + 1)
 		if err != nil {
-			return errors.Wrapf(err, "failed to obtain the vals #%d", pivot+1)
+			return nil, errors.Wrapf(err, "failed to obtain the vals #%d", pivot+1)
 		}
 		if !bytes.Equal(pivotHeader.NextValidatorsHash, nextVals.Hash()) {
-			return errors.Errorf("expected next validator's hash %X, but got %X (height #%d)",
+			return nil, errors.Errorf("expected next validator's hash %X, but got %X (height #%d)",
 				pivotHeader.NextValidatorsHash,
 				nextVals.Hash(),
 				pivot)
@@ -648,13 +711,89 @@ func (c *Client) bisection(
 
 		err = c.updateTrustedHeaderAndVals(pivotHeader, nextVals)
 		if err != nil {
-			return errors.Wrapf(err, "failed to update trusted state #%d", pivot)
+			return nil, errors.Wrapf(err, "failed to update trusted state #%d", pivot)
 		}
 
-		err = c.bisection(pivotHeader, nextVals, newHeader, newVals, now)
+		rightTrace, err := c.bisection(pivotHeader, nextVals, newHeader, newVals, now)
+		if err != nil {
+			return nil, errors.Wrapf(err, "bisection of #%d and #%d", pivot, newHeader.Height)
+		}
+		// rightTrace starts with the pivot header, which ends the trace without
+		// its next vals
+		trace = append(trace[:len(trace)-1], rightTrace...)
+	}
+
+	return trace, nil
+}
+
+// see VerifyHeader
+//
+// Witnesses are not consulted as the hash links can't be forged.
+func (c *Client) backwards(
+	firstHeight int64,
+	newHeader *types.SignedHeader,
+	newVals *types.ValidatorSet,
+	now time.Time) error {
+
+	// Ensure the first header can still be trusted.
+	trustedHeader, err := c.TrustedHeader(firstHeight, now)
+	if err != nil {
+		return errors.Wrapf(err, "can't get first trusted header #%d", firstHeight)
+	}
+
+	// 1) Verify any intermediate headers.
+	for height := firstHeight - 1; height > newHeader.Height; height-- {
+		interimHeader, err := c.primary.SignedHeader(height)
 		if err != nil {
-			return errors.Wrapf(err, "bisection of #%d and #%d", pivot, newHeader.Height)
+			return errors.Wrapf(err, "failed to obtain the header #%d", height)
 		}
+
+		c.logger.Debug("Verify newHeader against trustedHeader (backwards)",
+			"trustedHeight", trustedHeader.Height,
+			"trustedHash", trustedHeader.Hash(),
+			"newHeight", interimHeader.Height,
+			"newHash", interimHeader.Hash())
+		if err := VerifyBackwards(c.chainID, interimHeader, trustedHeader); err != nil {
+			return errors.Wrapf(err, "failed to verify the header #%d", height)
+		}
+
+		if err := c.saveBackwardsHeader(interimHeader, trustedHeader); err != nil {
+			return errors.Wrapf(err, "failed to save the header #%d", height)
+		}
+		trustedHeader = interimHeader
+	}
+
+	// 2) Verify the new header.
+	if err := VerifyBackwards(c.chainID, newHeader, trustedHeader); err != nil {
+		return errors.Wrapf(err, "failed to verify the header #%d", newHeader.Height)
+	}
+	if !bytes.Equal(newHeader.ValidatorsHash, newVals.Hash()) {
+		return errors.Errorf("expected new header validators (%X) to match those that were supplied (%X)",
+			newHeader.ValidatorsHash,
+			newVals.Hash())
+	}
+	return c.saveBackwardsHeader(newHeader, trustedHeader)
+}
+
+// persist header, verified backwards from the (trusted) next header, and its
+// next validators, which are fetched from primary provider, to trustedStore.
+// Unlike updateTrustedHeaderAndVals, the latest trusted header stays the same.
+func (c *Client) saveBackwardsHeader(h, nextHeader *types.SignedHeader) error {
+	nextVals, err := c.primary.ValidatorSet(nextHeader.Height)
+	if err != nil {
+		return errors.Wrapf(err, "failed to obtain the vals #%d", nextHeader.Height)
+	}
+	if !bytes.Equal(nextHeader.ValidatorsHash, nextVals.Hash()) {
+		return errors.Errorf("expected trusted header validators (%X) to match those that were supplied (%X)",
+			nextHeader.ValidatorsHash,
+			nextVals.Hash())
+	}
+	if !bytes.Equal(h.NextValidatorsHash, nextVals.Hash()) {
+		return errors.Errorf("expected next validator's hash %X, but got %X", h.NextValidatorsHash, nextVals.Hash())
+	}
+
+	if err := c.trustedStore.SaveSignedHeaderAndNextValidatorSet(h, nextVals); err != nil {
+		return errors.Wrap(err, "failed to save trusted header")
 	}
 
 	return nil
@@ -676,6 +815,17 @@ func (c *Client) updateTrustedHeaderAndVals(h *types.SignedHeader, nextVals *typ
 	return nil
 }
 
+// remove headers from trustedStore if it holds more than pruningSize.
+func (c *Client) pruneTrustedStore() error {
+	if c.pruningSize <= 0 {
+		return nil
+	}
+	if err := c.trustedStore.Prune(c.pruningSize); err != nil {
+		return errors.Wrap(err, "failed to prune trusted store")
+	}
+	return nil
+}
+
 // fetch header and validators for the given height from primary provider.
 func (c *Client) fetchHeaderAndValsAtHeight(height int64) (*types.SignedHeader, *types.ValidatorSet, error) {
 	h, err := c.primary.SignedHeader(height)
@@ -689,30 +839,6 @@ func (c *Client) fetchHeaderAndValsAtHeight(height int64) (*types.SignedHeader,
 	return h, vals, nil
 }
 
-// compare header with one from a random alternative provider.
-func (c *Client) compareNewHeaderWithRandomAlternative(h *types.SignedHeader) error {
-	// 1. Pick an alternative provider.
-	p := c.alternatives[tmrand.Intn(len(c.alternatives))]
-
-	// 2. Fetch the header.
-	altHeader, err := p.SignedHeader(h.Height)
-	if err != nil {
-		return errors.Wrapf(err,
-			"failed to obtain header #%d from alternative provider %v", h.Height, p)
-	}
-
-	// 3. Compare hashes.
-	if !bytes.Equal(h.Hash(), altHeader.Hash()) {
-		// TODO: One of the providers is lying. Send the evidence to fork
-		// accountability server.
-		return errors.Errorf(
-			"new header hash %X does not match one from alternative provider %X",
-			h.Hash(), altHeader.Hash())
-	}
-
-	return nil
-}
-
 func (c *Client) removeNoLongerTrustedHeadersRoutine() {
 	defer c.routinesWaitGroup.Done()
 
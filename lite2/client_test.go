@@ -359,6 +359,60 @@ func TestClient_Cleanup(t *testing.T) {
 	assert.Nil(t, h)
 }
 
+func TestClient_PruningSize(t *testing.T) {
+	const (
+		chainID = "TestClient_PruningSize"
+	)
+
+	var (
+		keys     = genPrivKeys(4)
+		vals     = keys.ToValidators(20, 10)
+		bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		headers  = make(map[int64]*types.SignedHeader)
+		valSets  = make(map[int64]*types.ValidatorSet)
+	)
+	for height := int64(1); height <= 4; height++ {
+		headers[height] = keys.GenSignedHeader(chainID, height, bTime.Add(time.Duration(height)*time.Minute), nil,
+			vals, vals, []byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys))
+		valSets[height] = vals
+	}
+	valSets[5] = vals
+
+	trustedStore := dbs.New(dbm.NewMemDB(), chainID)
+	c, err := NewClient(
+		chainID,
+		TrustOptions{
+			Period: 4 * time.Hour,
+			Height: 1,
+			Hash:   headers[1].Hash(),
+		},
+		mockp.New(chainID, headers, valSets),
+		trustedStore,
+		SequentialVerification(),
+		PruningSize(2),
+		Logger(log.TestingLogger()),
+	)
+	require.NoError(t, err)
+	defer c.Stop()
+
+	now := bTime.Add(5 * time.Minute)
+	_, err = c.VerifyHeaderAtHeight(4, now)
+	require.NoError(t, err)
+
+	// Only the 2 latest headers are left.
+	size, err := trustedStore.Size()
+	require.NoError(t, err)
+	assert.Equal(t, 2, size)
+
+	height, err := c.FirstTrustedHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, height)
+
+	h, err := c.TrustedHeader(4, now)
+	assert.NoError(t, err)
+	assert.NotNil(t, h)
+}
+
 // trustedHeader.Height == options.Height
 func TestClientRestoreTrustedHeaderAfterStartup1(t *testing.T) {
 	const (
@@ -788,3 +842,97 @@ func TestClient_Concurrency(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestClient_BackwardsVerification(t *testing.T) {
+	const (
+		chainID = "TestClient_BackwardsVerification"
+	)
+
+	var (
+		keys     = genPrivKeys(4)
+		vals     = keys.ToValidators(20, 10)
+		bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		h1       = keys.GenSignedHeader(chainID, 1, bTime, nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys))
+		h2 = keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys),
+			types.BlockID{Hash: h1.Hash()})
+		h3 = keys.GenSignedHeaderLastBlockID(chainID, 3, bTime.Add(1*time.Hour), nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys),
+			types.BlockID{Hash: h2.Hash()})
+		valSets = map[int64]*types.ValidatorSet{
+			1: vals,
+			2: vals,
+			3: vals,
+			4: vals,
+		}
+		now = bTime.Add(2 * time.Hour)
+	)
+
+	testCases := map[string]struct {
+		headers   map[int64]*types.SignedHeader
+		verifyErr bool
+	}{
+		"good": {
+			map[int64]*types.SignedHeader{1: h1, 2: h2, 3: h3},
+			false,
+		},
+		"header #2 does not link to trusted header #3": {
+			map[int64]*types.SignedHeader{
+				1: h1,
+				2: keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+					[]byte("app_hash2"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys),
+					types.BlockID{Hash: h1.Hash()}),
+				3: h3,
+			},
+			true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			c, err := NewClient(
+				chainID,
+				TrustOptions{
+					Period: 4 * time.Hour,
+					Height: 3,
+					Hash:   h3.Hash(),
+				},
+				mockp.New(chainID, tc.headers, valSets),
+				dbs.New(dbm.NewMemDB(), chainID),
+				UpdatePeriod(0),
+				Logger(log.TestingLogger()),
+			)
+			require.NoError(t, err)
+			defer c.Stop()
+
+			h, err := c.VerifyHeaderAtHeight(1, now)
+			if tc.verifyErr {
+				assert.Error(t, err)
+				_, err = c.TrustedHeader(1, now)
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.EqualValues(t, h1.Hash(), h.Hash())
+
+			// all the headers down to #1 are saved, the latest one stays the same
+			for height := int64(1); height <= 3; height++ {
+				h, err := c.TrustedHeader(height, now)
+				require.NoError(t, err)
+				assert.EqualValues(t, height, h.Height)
+			}
+			lastHeight, err := c.LastTrustedHeight()
+			require.NoError(t, err)
+			assert.EqualValues(t, 3, lastHeight)
+			firstHeight, err := c.FirstTrustedHeight()
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, firstHeight)
+
+			// trusted headers can't be verified again
+			_, err = c.VerifyHeaderAtHeight(2, now)
+			assert.Error(t, err)
+		})
+	}
+}
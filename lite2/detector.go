@@ -0,0 +1,166 @@
+package lite
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/lite2/provider"
+	"github.com/tendermint/tendermint/types"
+)
+
+// tracedHeader is a header of the verification trace, with the validator set
+// of the next height it was verified with (nil for the new header).
+type tracedHeader struct {
+	*types.SignedHeader
+	nextVals *types.ValidatorSet
+}
+
+// detectDivergence cross-checks the verification trace (headers from the
+// primary, starting with the previously trusted header and ending with the
+// new one) with every witness.
+//
+// For each witness, the headers are compared in ascending height order. The
+// first header, which does not match, is checked against the validator set
+// of the previous (common) header. If {trustLevel} of it signed the witness's
+// header, there's a fork: the evidence is reported to the primary and all the
+// witnesses, the headers from the diverged height onwards are removed and
+// ErrConflictingHeaders is returned. Otherwise, the witness is faulty and gets
+// removed.
+//
+// Witnesses, which fail to provide the headers, are skipped.
+func (c *Client) detectDivergence(trace []tracedHeader, now time.Time) error {
+	if len(c.alternatives) == 0 || len(trace) < 2 {
+		return nil
+	}
+
+	var (
+		faultyWitnesses = make(map[int]struct{})
+		conflictErr     *ErrConflictingHeaders
+		divergedIdx     int
+	)
+	for i, witness := range c.alternatives {
+		idx, altHeader, err := c.compareTraceWithWitness(trace, witness)
+		if err != nil {
+			c.logger.Error("Failed to cross-check headers with witness", "witness", witness, "err", err)
+			continue
+		}
+		if altHeader == nil { // all headers match
+			continue
+		}
+
+		// Check the witness's header is signed by {trustLevel} of the validator
+		// set we trust at the previous height.
+		err = c.verifyConflictingHeader(trace[idx-1], altHeader, now)
+		if err != nil {
+			c.logger.Error("Witness sent us an invalid header", "witness", witness, "height", altHeader.Height,
+				"err", err)
+			faultyWitnesses[i] = struct{}{}
+			continue
+		}
+
+		conflictErr = &ErrConflictingHeaders{
+			H1:      trace[idx].SignedHeader,
+			Primary: c.primary,
+			H2:      altHeader,
+			Witness: witness,
+		}
+		divergedIdx = idx
+		break
+	}
+
+	c.removeWitnesses(faultyWitnesses)
+
+	if conflictErr == nil {
+		return nil
+	}
+
+	c.logger.Error("Detected conflicting headers", "err", conflictErr)
+	c.reportEvidence(types.NewConflictingHeadersEvidence(conflictErr.H1, conflictErr.H2))
+
+	// The new header is not saved yet, so only the intermediate headers from
+	// the diverged height onwards need to be removed. The last common header
+	// is trusted again (and saved again, in case it was evicted).
+	for _, h := range trace[divergedIdx : len(trace)-1] {
+		if err := c.trustedStore.DeleteSignedHeaderAndNextValidatorSet(h.Height); err != nil {
+			c.logger.Error("can't remove a trusted header & validator set", "err", err, "height", h.Height)
+		}
+	}
+	common := trace[divergedIdx-1]
+	if err := c.updateTrustedHeaderAndVals(common.SignedHeader, common.nextVals); err != nil {
+		return errors.Wrapf(err, "failed to restore trusted state after %v", conflictErr)
+	}
+
+	return *conflictErr
+}
+
+// compareTraceWithWitness returns the index of the first header in the trace,
+// which does not match the witness's header, and the witness's header.
+// altHeader is nil if all the headers match.
+func (c *Client) compareTraceWithWitness(
+	trace []tracedHeader,
+	witness provider.Provider) (idx int, altHeader *types.SignedHeader, err error) {
+
+	// trace[0] is the previously trusted header, which was already checked.
+	for idx = 1; idx < len(trace); idx++ {
+		h := trace[idx]
+
+		altHeader, err = witness.SignedHeader(h.Height)
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "failed to obtain the header #%d", h.Height)
+		}
+
+		if !bytes.Equal(h.Hash(), altHeader.Hash()) {
+			return idx, altHeader, nil
+		}
+	}
+
+	return 0, nil, nil
+}
+
+// verifyConflictingHeader checks the conflicting header is valid and signed by
+// {trustLevel} of the next validator set of the (trusted) header before it.
+// The validator set is taken from the trace, since the trusted store may have
+// evicted it during the verification.
+func (c *Client) verifyConflictingHeader(
+	lastHeader tracedHeader,
+	altHeader *types.SignedHeader,
+	now time.Time) error {
+
+	if err := altHeader.ValidateBasic(c.chainID); err != nil {
+		return err
+	}
+	if HeaderExpired(altHeader, c.trustingPeriod, now) {
+		return ErrOldHeaderExpired{altHeader.Time.Add(c.trustingPeriod), now}
+	}
+
+	return lastHeader.nextVals.VerifyCommitTrusting(c.chainID, altHeader.Commit.BlockID, altHeader.Height,
+		altHeader.Commit, c.trustLevel)
+}
+
+// reportEvidence sends the evidence to the primary and all the witnesses.
+func (c *Client) reportEvidence(ev types.Evidence) {
+	for _, p := range append([]provider.Provider{c.primary}, c.alternatives...) {
+		if err := p.ReportEvidence(ev); err != nil {
+			c.logger.Error("Failed to report evidence", "provider", p, "err", err)
+		}
+	}
+}
+
+// removeWitnesses removes the witnesses with the given indexes.
+func (c *Client) removeWitnesses(indexes map[int]struct{}) {
+	if len(indexes) == 0 {
+		return
+	}
+
+	witnesses := make([]provider.Provider, 0, len(c.alternatives)-len(indexes))
+	for i, witness := range c.alternatives {
+		if _, ok := indexes[i]; ok {
+			c.logger.Info("Removing faulty witness", "witness", witness)
+			continue
+		}
+		witnesses = append(witnesses, witness)
+	}
+	c.alternatives = witnesses
+}
@@ -0,0 +1,136 @@
+package lite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/lite2/provider"
+	mockp "github.com/tendermint/tendermint/lite2/provider/mock"
+	"github.com/tendermint/tendermint/lite2/store"
+	dbs "github.com/tendermint/tendermint/lite2/store/db"
+	"github.com/tendermint/tendermint/lite2/store/lru"
+	"github.com/tendermint/tendermint/types"
+)
+
+type evidenceChecker interface {
+	HasEvidence(ev types.Evidence) bool
+}
+
+func TestClient_DetectDivergence(t *testing.T) {
+	const (
+		chainID = "detect-divergence"
+	)
+
+	var (
+		keys     = genPrivKeys(4)
+		vals     = keys.ToValidators(20, 10)
+		bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		header   = keys.GenSignedHeader(chainID, 1, bTime, nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys))
+		headers = map[int64]*types.SignedHeader{
+			1: header,
+			2: keys.GenSignedHeader(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+				[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys)),
+			3: keys.GenSignedHeader(chainID, 3, bTime.Add(1*time.Hour), nil, vals, vals,
+				[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys)),
+			4: keys.GenSignedHeader(chainID, 4, bTime.Add(90*time.Minute), nil, vals, vals,
+				[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys)),
+		}
+		valSets = map[int64]*types.ValidatorSet{
+			1: vals,
+			2: vals,
+			3: vals,
+			4: vals,
+			5: vals,
+		}
+
+		// same validators signing a different header at height 2
+		forkedHeaders = map[int64]*types.SignedHeader{
+			1: header,
+			2: keys.GenSignedHeader(chainID, 2, bTime.Add(30*time.Minute), nil, vals, vals,
+				[]byte("app_hash2"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys)),
+			3: keys.GenSignedHeader(chainID, 3, bTime.Add(1*time.Hour), nil, vals, vals,
+				[]byte("app_hash2"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys)),
+		}
+
+		// unknown validators signing a different header at height 3
+		newKeys        = genPrivKeys(4)
+		newVals        = newKeys.ToValidators(10, 1)
+		invalidHeaders = map[int64]*types.SignedHeader{
+			1: header,
+			2: headers[2],
+			3: newKeys.GenSignedHeader(chainID, 3, bTime.Add(1*time.Hour), nil, newVals, newVals,
+				[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(newKeys)),
+		}
+	)
+
+	testCases := map[string]struct {
+		witnessHeaders   map[int64]*types.SignedHeader
+		height           int64
+		lruSize          int   // 0 - db store
+		conflictHeight   int64 // 0 - no conflict
+		witnessesAfter   int
+		lastTrustedAfter int64
+	}{
+		"honest witness": {headers, 3, 0, 0, 1, 3},
+		"forked witness": {forkedHeaders, 3, 0, 2, 1, 1},
+		"faulty witness": {invalidHeaders, 3, 0, 0, 0, 3},
+		// the trusted header #1 is evicted during the verification
+		"forked witness, small store": {forkedHeaders, 4, 2, 2, 1, 1},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			primary := mockp.New(chainID, headers, valSets)
+			witness := mockp.New(chainID, tc.witnessHeaders, valSets)
+			var trustedStore store.Store = dbs.New(dbm.NewMemDB(), chainID)
+			if tc.lruSize > 0 {
+				trustedStore = lru.New(tc.lruSize)
+			}
+
+			c, err := NewClient(
+				chainID,
+				TrustOptions{
+					Period: 4 * time.Hour,
+					Height: 1,
+					Hash:   header.Hash(),
+				},
+				primary,
+				trustedStore,
+				SequentialVerification(),
+				AlternativeSources([]provider.Provider{witness}),
+				UpdatePeriod(0),
+			)
+			require.NoError(t, err)
+			defer c.Stop()
+
+			_, err = c.VerifyHeaderAtHeight(tc.height, bTime.Add(2*time.Hour))
+			if tc.conflictHeight > 0 {
+				require.Error(t, err)
+				conflictErr, ok := errors.Cause(err).(ErrConflictingHeaders)
+				require.True(t, ok, "expected ErrConflictingHeaders, got %v", err)
+				assert.EqualValues(t, tc.conflictHeight, conflictErr.H1.Height)
+
+				// the evidence is reported to both providers
+				ev := types.NewConflictingHeadersEvidence(headers[tc.conflictHeight],
+					tc.witnessHeaders[tc.conflictHeight])
+				assert.True(t, primary.(evidenceChecker).HasEvidence(ev))
+				assert.True(t, witness.(evidenceChecker).HasEvidence(ev))
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Len(t, c.alternatives, tc.witnessesAfter)
+			lastHeight, err := c.LastTrustedHeight()
+			require.NoError(t, err)
+			assert.EqualValues(t, tc.lastTrustedAfter, lastHeight)
+		})
+	}
+}
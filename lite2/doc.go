@@ -90,6 +90,25 @@ Example usage:
 		}
 		fmt.Println("got header", h)
 
+If witnesses are given (AlternativeSources option), every header verified by
+the client is cross-checked with them. When a witness returns a conflicting
+header, which is also signed by enough of the trusted validators, the client
+reports ConflictingHeadersEvidence to the primary and all the witnesses and
+returns ErrConflictingHeaders. Witnesses returning invalid headers are
+dropped.
+
+Headers can be obtained from full nodes either over RPC (provider/http) or
+over the p2p light block channel (provider/p2p), which every full node serves.
+In the latter case, witnesses can be picked from the PEX address book (see
+p2p.WitnessesFromAddrBook).
+
+Trusted headers are kept in a store: store/db (any tm-db database), store/lru
+(in-memory, with a maximum number of headers) or store/sql (SQLite or
+PostgreSQL, which can be shared by multiple processes). By default the store
+is not pruned; the number of headers can be limited with the PruningSize
+option. All stores accept pinned heights (e.g. the trust root and
+checkpoints), which are never pruned.
+
 ## 2. Pure functions to verify a new header (see verifier.go)
 
 Verify function verifies a new header against some trusted header. See
@@ -104,6 +123,11 @@ signatures from non-existent validators to justify it. Secure RPC proxy serves
 as a wrapper, which verifies all the headers, using a light client connected to
 some other node.
 
+Transactions (with proofs), block results (against LastResultsHash of the next
+header) and validators are verified too. So are NewBlock, NewBlockHeader and Tx
+events pushed to websocket subscribers; events, which can't be verified, are
+dropped.
+
 See
 https://github.com/tendermint/tendermint/blob/master/cmd/tendermint/commands/lite.go
 for usage example.
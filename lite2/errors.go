@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/tendermint/tendermint/lite2/provider"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -28,3 +29,18 @@ type ErrNewValSetCantBeTrusted struct {
 func (e ErrNewValSetCantBeTrusted) Error() string {
 	return fmt.Sprintf("cant trust new val set: %v", e.Reason)
 }
+
+// ErrConflictingHeaders is thrown when two conflicting headers are discovered,
+// both signed by at least {trustLevel} of the trusted validator set.
+type ErrConflictingHeaders struct {
+	H1      *types.SignedHeader
+	Primary provider.Provider
+
+	H2      *types.SignedHeader
+	Witness provider.Provider
+}
+
+func (e ErrConflictingHeaders) Error() string {
+	return fmt.Sprintf("header hash %X from primary %v does not match one %X from witness %v",
+		e.H1.Hash(), e.Primary, e.H2.Hash(), e.Witness)
+}
@@ -1,4 +1,4 @@
-package lite
+package lite_test
 
 import (
 	"fmt"
@@ -12,6 +12,7 @@ import (
 
 	"github.com/tendermint/tendermint/abci/example/kvstore"
 	"github.com/tendermint/tendermint/libs/log"
+	lite "github.com/tendermint/tendermint/lite2"
 	httpp "github.com/tendermint/tendermint/lite2/provider/http"
 	dbs "github.com/tendermint/tendermint/lite2/store/db"
 	rpctest "github.com/tendermint/tendermint/rpc/test"
@@ -48,17 +49,17 @@ func TestExample_Client_AutoUpdate(t *testing.T) {
 		stdlog.Fatal(err)
 	}
 
-	c, err := NewClient(
+	c, err := lite.NewClient(
 		chainID,
-		TrustOptions{
+		lite.TrustOptions{
 			Period: 504 * time.Hour, // 21 days
 			Height: 2,
 			Hash:   header.Hash(),
 		},
 		provider,
 		dbs.New(db, chainID),
-		UpdatePeriod(1*time.Second),
-		Logger(log.TestingLogger()),
+		lite.UpdatePeriod(1*time.Second),
+		lite.Logger(log.TestingLogger()),
 	)
 	if err != nil {
 		stdlog.Fatal(err)
@@ -110,17 +111,17 @@ func TestExample_Client_ManualUpdate(t *testing.T) {
 		stdlog.Fatal(err)
 	}
 
-	c, err := NewClient(
+	c, err := lite.NewClient(
 		chainID,
-		TrustOptions{
+		lite.TrustOptions{
 			Period: 504 * time.Hour, // 21 days
 			Height: 2,
 			Hash:   header.Hash(),
 		},
 		provider,
 		dbs.New(db, chainID),
-		UpdatePeriod(0),
-		Logger(log.TestingLogger()),
+		lite.UpdatePeriod(0),
+		lite.Logger(log.TestingLogger()),
 	)
 	if err != nil {
 		stdlog.Fatal(err)
@@ -8,17 +8,19 @@ import (
 // mock provider allows to directly set headers & vals, which can be handy when
 // testing.
 type mock struct {
-	chainID string
-	headers map[int64]*types.SignedHeader
-	vals    map[int64]*types.ValidatorSet
+	chainID  string
+	headers  map[int64]*types.SignedHeader
+	vals     map[int64]*types.ValidatorSet
+	evidence map[string]types.Evidence // hash => evidence
 }
 
 // New creates a mock provider.
 func New(chainID string, headers map[int64]*types.SignedHeader, vals map[int64]*types.ValidatorSet) provider.Provider {
 	return &mock{
-		chainID: chainID,
-		headers: headers,
-		vals:    vals,
+		chainID:  chainID,
+		headers:  headers,
+		vals:     vals,
+		evidence: make(map[string]types.Evidence),
 	}
 }
 
@@ -45,3 +47,14 @@ func (p *mock) ValidatorSet(height int64) (*types.ValidatorSet, error) {
 	}
 	return nil, provider.ErrValidatorSetNotFound
 }
+
+func (p *mock) ReportEvidence(ev types.Evidence) error {
+	p.evidence[string(ev.Hash())] = ev
+	return nil
+}
+
+// HasEvidence returns true if the evidence was reported to the mock provider.
+func (p *mock) HasEvidence(ev types.Evidence) bool {
+	_, ok := p.evidence[string(ev.Hash())]
+	return ok
+}
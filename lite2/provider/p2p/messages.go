@@ -0,0 +1,119 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// maxMsgSize is the maximum size of any message (a light block with a big
+	// validator set or a piece of evidence with two of them).
+	maxMsgSize = int(4e6)
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	RegisterMessages(cdc)
+	types.RegisterBlockAmino(cdc)
+}
+
+// Message is a message sent and received by the reactor.
+type Message interface {
+	ValidateBasic() error
+}
+
+// RegisterMessages registers the light block messages for amino encoding.
+func RegisterMessages(cdc *amino.Codec) {
+	cdc.RegisterInterface((*Message)(nil), nil)
+	cdc.RegisterConcrete(&lightBlockRequestMessage{}, "tendermint/lite/LightBlockRequest", nil)
+	cdc.RegisterConcrete(&lightBlockResponseMessage{}, "tendermint/lite/LightBlockResponse", nil)
+	cdc.RegisterConcrete(&evidenceMessage{}, "tendermint/lite/Evidence", nil)
+}
+
+// decodeMsg decodes a message.
+func decodeMsg(bz []byte) (msg Message, err error) {
+	if len(bz) > maxMsgSize {
+		return msg, fmt.Errorf("msg exceeds max size (%d > %d)", len(bz), maxMsgSize)
+	}
+	err = cdc.UnmarshalBinaryBare(bz, &msg)
+	return
+}
+
+//-------------------------------------
+
+// lightBlockRequestMessage requests the signed header and the validator set at
+// the given height (0 - the latest) from a peer.
+type lightBlockRequestMessage struct {
+	Height int64
+}
+
+// ValidateBasic implements Message.
+func (m *lightBlockRequestMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative height")
+	}
+	return nil
+}
+
+func (m *lightBlockRequestMessage) String() string {
+	return fmt.Sprintf("[lightBlockRequestMessage %v]", m.Height)
+}
+
+// lightBlockResponseMessage contains the signed header and the validator set
+// at a single height, or marks them as missing if the peer does not have them.
+type lightBlockResponseMessage struct {
+	Height       int64
+	SignedHeader *types.SignedHeader
+	ValidatorSet *types.ValidatorSet
+	Missing      bool
+}
+
+// ValidateBasic implements Message.
+func (m *lightBlockResponseMessage) ValidateBasic() error {
+	if m.Missing {
+		if m.SignedHeader != nil || m.ValidatorSet != nil {
+			return errors.New("missing light block cannot have contents")
+		}
+		return nil
+	}
+
+	if m.Height <= 0 {
+		return errors.New("height must be greater than 0")
+	}
+	if m.SignedHeader == nil || m.SignedHeader.Header == nil || m.SignedHeader.Commit == nil {
+		return errors.New("signed header cannot be nil")
+	}
+	if m.SignedHeader.Height != m.Height {
+		return fmt.Errorf("signed header height %d does not match %d", m.SignedHeader.Height, m.Height)
+	}
+	if m.ValidatorSet.IsNilOrEmpty() {
+		return errors.New("validator set cannot be empty")
+	}
+	return nil
+}
+
+func (m *lightBlockResponseMessage) String() string {
+	return fmt.Sprintf("[lightBlockResponseMessage %v missing=%v]", m.Height, m.Missing)
+}
+
+// evidenceMessage reports a piece of evidence to a peer.
+type evidenceMessage struct {
+	Evidence types.Evidence
+}
+
+// ValidateBasic implements Message.
+func (m *evidenceMessage) ValidateBasic() error {
+	if m.Evidence == nil {
+		return errors.New("evidence cannot be nil")
+	}
+	return m.Evidence.ValidateBasic()
+}
+
+func (m *evidenceMessage) String() string {
+	return fmt.Sprintf("[evidenceMessage %v]", m.Evidence)
+}
@@ -0,0 +1,51 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestLightBlockRequestMessage_ValidateBasic(t *testing.T) {
+	assert.NoError(t, (&lightBlockRequestMessage{Height: 0}).ValidateBasic())
+	assert.NoError(t, (&lightBlockRequestMessage{Height: 1}).ValidateBasic())
+	assert.Error(t, (&lightBlockRequestMessage{Height: -1}).ValidateBasic())
+}
+
+func TestLightBlockResponseMessage_ValidateBasic(t *testing.T) {
+	valSet, privVals := types.RandValidatorSet(1, 10)
+	sh := makeSignedHeader(t, 1, valSet, privVals)
+
+	testcases := map[string]struct {
+		msg   *lightBlockResponseMessage
+		valid bool
+	}{
+		"valid":             {&lightBlockResponseMessage{Height: 1, SignedHeader: sh, ValidatorSet: valSet}, true},
+		"missing":           {&lightBlockResponseMessage{Height: 1, Missing: true}, true},
+		"missing with vals": {&lightBlockResponseMessage{Height: 1, ValidatorSet: valSet, Missing: true}, false},
+		"no height":         {&lightBlockResponseMessage{Height: 0, SignedHeader: sh, ValidatorSet: valSet}, false},
+		"wrong height":      {&lightBlockResponseMessage{Height: 2, SignedHeader: sh, ValidatorSet: valSet}, false},
+		"no header":         {&lightBlockResponseMessage{Height: 1, ValidatorSet: valSet}, false},
+		"no vals":           {&lightBlockResponseMessage{Height: 1, SignedHeader: sh}, false},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestEvidenceMessage_ValidateBasic(t *testing.T) {
+	ev := types.NewMockEvidence(1, time.Now(), 0, []byte("val"))
+	assert.NoError(t, (&evidenceMessage{Evidence: ev}).ValidateBasic())
+	assert.Error(t, (&evidenceMessage{}).ValidateBasic())
+}
@@ -0,0 +1,158 @@
+package p2p
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/lite2/provider"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/pex"
+	"github.com/tendermint/tendermint/types"
+)
+
+const defaultRequestTimeout = 10 * time.Second
+
+// p2p provider requests light blocks from a single full node over the light
+// block channel. The reactor must be added to a running switch.
+type p2pProvider struct {
+	chainID string
+	reactor *Reactor
+	addr    *p2p.NetAddress
+	timeout time.Duration
+
+	// only one request per peer can be in progress
+	mtx sync.Mutex
+}
+
+// New creates a p2p provider, which requests light blocks from the full node
+// at the given address. The node is dialed (using the reactor's switch) when
+// the first request is made.
+func New(chainID string, r *Reactor, addr *p2p.NetAddress) provider.Provider {
+	return &p2pProvider{
+		chainID: chainID,
+		reactor: r,
+		addr:    addr,
+		timeout: defaultRequestTimeout,
+	}
+}
+
+// WitnessesFromAddrBook creates p2p providers for up to n random addresses
+// from the address book, except the excluded ones (e.g. the primary).
+func WitnessesFromAddrBook(chainID string, r *Reactor, book pex.AddrBook, n int,
+	exclude ...p2p.ID) []provider.Provider {
+
+	excluded := make(map[p2p.ID]struct{}, len(exclude))
+	for _, id := range exclude {
+		excluded[id] = struct{}{}
+	}
+
+	witnesses := make([]provider.Provider, 0, n)
+	for _, addr := range book.GetSelection() {
+		if len(witnesses) >= n {
+			break
+		}
+		if _, ok := excluded[addr.ID]; ok {
+			continue
+		}
+		excluded[addr.ID] = struct{}{}
+		witnesses = append(witnesses, New(chainID, r, addr))
+	}
+	return witnesses
+}
+
+// ChainID returns a chainID this provider was configured with.
+func (p *p2pProvider) ChainID() string {
+	return p.chainID
+}
+
+func (p *p2pProvider) String() string {
+	return fmt.Sprintf("p2p{%v}", p.addr)
+}
+
+// SignedHeader requests a SignedHeader at the given height and checks the
+// chainID matches.
+func (p *p2pProvider) SignedHeader(height int64) (*types.SignedHeader, error) {
+	resp, err := p.lightBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Missing {
+		return nil, provider.ErrSignedHeaderNotFound
+	}
+
+	// Verify we're still on the same chain.
+	if p.chainID != resp.SignedHeader.ChainID {
+		return nil, fmt.Errorf("expected chainID %s, got %s", p.chainID, resp.SignedHeader.ChainID)
+	}
+
+	return resp.SignedHeader, nil
+}
+
+// ValidatorSet requests a ValidatorSet at the given height.
+func (p *p2pProvider) ValidatorSet(height int64) (*types.ValidatorSet, error) {
+	resp, err := p.lightBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Missing {
+		return nil, provider.ErrValidatorSetNotFound
+	}
+	return resp.ValidatorSet, nil
+}
+
+// ReportEvidence sends the evidence to the full node, which adds it to its
+// evidence pool.
+func (p *p2pProvider) ReportEvidence(ev types.Evidence) error {
+	peer, err := p.peer()
+	if err != nil {
+		return err
+	}
+	return p.reactor.reportEvidence(peer, ev)
+}
+
+func (p *p2pProvider) lightBlock(height int64) (*lightBlockResponseMessage, error) {
+	if height < 0 {
+		return nil, fmt.Errorf("expected height >= 0, got height %d", height)
+	}
+
+	peer, err := p.peer()
+	if err != nil {
+		return nil, err
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.reactor.requestLightBlock(peer, height, p.timeout)
+}
+
+// peer returns the connected peer, dialing it if needed, and checks it serves
+// light blocks.
+func (p *p2pProvider) peer() (p2p.Peer, error) {
+	sw := p.reactor.Switch
+	if sw == nil {
+		return nil, errors.New("reactor is not added to a switch")
+	}
+
+	peer := sw.Peers().Get(p.addr.ID)
+	if peer == nil {
+		if err := sw.DialPeerWithAddress(p.addr); err != nil {
+			return nil, errors.Wrapf(err, "failed to dial %v", p.addr)
+		}
+		peer = sw.Peers().Get(p.addr.ID)
+		if peer == nil {
+			return nil, errors.Errorf("peer %v disconnected", p.addr)
+		}
+	}
+
+	if ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo); ok {
+		if !bytes.Contains(ni.Channels, []byte{LightBlockChannel}) {
+			return nil, errors.Errorf("peer %v does not serve light blocks", p.addr)
+		}
+	}
+
+	return peer, nil
+}
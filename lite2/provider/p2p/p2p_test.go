@@ -0,0 +1,210 @@
+package p2p
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/lite2/provider"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/pex"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+const chainID = "test_chain_id"
+
+func init() {
+	types.RegisterMockEvidences(cdc)
+}
+
+type mockBlockStore struct {
+	sm.BlockStoreRPC
+	headers map[int64]*types.SignedHeader
+}
+
+func (bs mockBlockStore) Base() int64   { return 1 }
+func (bs mockBlockStore) Height() int64 { return int64(len(bs.headers)) }
+
+func (bs mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	h, ok := bs.headers[height]
+	if !ok {
+		return nil
+	}
+	return &types.BlockMeta{BlockID: h.Commit.BlockID, Header: *h.Header}
+}
+
+func (bs mockBlockStore) LoadBlockCommit(height int64) *types.Commit {
+	if h, ok := bs.headers[height]; ok && height < bs.Height() {
+		return h.Commit
+	}
+	return nil
+}
+
+func (bs mockBlockStore) LoadSeenCommit(height int64) *types.Commit {
+	if h, ok := bs.headers[height]; ok {
+		return h.Commit
+	}
+	return nil
+}
+
+type mockEvidencePool struct {
+	mtx      sync.Mutex
+	evidence []types.Evidence
+}
+
+func (p *mockEvidencePool) AddEvidence(ev types.Evidence) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.evidence = append(p.evidence, ev)
+	return nil
+}
+
+func (p *mockEvidencePool) size() int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return len(p.evidence)
+}
+
+func makeSignedHeader(t *testing.T, height int64, valSet *types.ValidatorSet,
+	privVals []types.PrivValidator) *types.SignedHeader {
+
+	header := &types.Header{
+		ChainID:        chainID,
+		Height:         height,
+		Time:           tmtime.Now(),
+		ValidatorsHash: valSet.Hash(),
+	}
+	blockID := types.BlockID{
+		Hash:        header.Hash(),
+		PartsHeader: types.PartSetHeader{Total: 1, Hash: []byte("parts_hash_parts_hash_parts_hash")},
+	}
+	voteSet := types.NewVoteSet(chainID, height, 0, types.PrecommitType, valSet)
+	commit, err := types.MakeCommit(blockID, height, 0, voteSet, privVals)
+	require.NoError(t, err)
+	return &types.SignedHeader{Header: header, Commit: commit}
+}
+
+// makeFullNodeAndLightClient connects a full node serving 3 light blocks with
+// a light client.
+func makeFullNodeAndLightClient(t *testing.T) (
+	fullNode, lightClient *p2p.Switch,
+	headers map[int64]*types.SignedHeader,
+	valSet *types.ValidatorSet,
+	evpool *mockEvidencePool) {
+
+	valSet, privVals := types.RandValidatorSet(2, 10)
+	headers = make(map[int64]*types.SignedHeader)
+	for height := int64(1); height <= 3; height++ {
+		headers[height] = makeSignedHeader(t, height, valSet, privVals)
+	}
+
+	stateDB := dbm.NewMemDB()
+	state := sm.State{
+		ChainID:                     chainID,
+		Validators:                  valSet,
+		NextValidators:              valSet,
+		LastHeightValidatorsChanged: 1,
+	}
+	for height := int64(0); height <= 3; height++ {
+		state.LastBlockHeight = height
+		sm.SaveState(stateDB, state)
+	}
+
+	evpool = &mockEvidencePool{}
+	reactors := []*Reactor{
+		NewReactor(stateDB, mockBlockStore{headers: headers}, evpool),
+		NewReactor(nil, nil, nil),
+	}
+	switches := p2p.MakeConnectedSwitches(config.DefaultP2PConfig(), 2, func(i int, sw *p2p.Switch) *p2p.Switch {
+		sw.AddReactor("LIGHTBLOCK", reactors[i])
+		return sw
+	}, p2p.Connect2Switches)
+	return switches[0], switches[1], headers, valSet, evpool
+}
+
+func TestProvider(t *testing.T) {
+	fullNode, lightClient, headers, valSet, evpool := makeFullNodeAndLightClient(t)
+	defer fullNode.Stop()
+	defer lightClient.Stop()
+
+	addr, err := p2p.NewNetAddressString(p2p.IDAddressString(fullNode.NodeInfo().ID(),
+		fullNode.NodeInfo().(p2p.DefaultNodeInfo).ListenAddr))
+	require.NoError(t, err)
+	reactor := lightClient.Reactor("LIGHTBLOCK").(*Reactor)
+	p := New(chainID, reactor, addr)
+	assert.Equal(t, chainID, p.ChainID())
+
+	// historical height
+	sh, err := p.SignedHeader(2)
+	require.NoError(t, err)
+	assert.Equal(t, headers[2].Hash(), sh.Hash())
+	assert.NoError(t, sh.ValidateBasic(chainID))
+	vals, err := p.ValidatorSet(2)
+	require.NoError(t, err)
+	assert.Equal(t, valSet.Hash(), vals.Hash())
+
+	// latest height
+	sh, err = p.SignedHeader(0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, sh.Height)
+	assert.Equal(t, headers[3].Hash(), sh.Hash())
+
+	// missing height
+	_, err = p.SignedHeader(10)
+	assert.Equal(t, provider.ErrSignedHeaderNotFound, err)
+	_, err = p.ValidatorSet(10)
+	assert.Equal(t, provider.ErrValidatorSetNotFound, err)
+	_, err = p.SignedHeader(-1)
+	assert.Error(t, err)
+
+	// evidence is added to the full node's evidence pool
+	ev := types.NewMockEvidence(2, time.Now(), 0, []byte("val"))
+	require.NoError(t, p.ReportEvidence(ev))
+	assert.Eventually(t, func() bool { return evpool.size() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// a different chain ID is rejected
+	_, err = New("other_chain", reactor, addr).SignedHeader(1)
+	assert.Error(t, err)
+}
+
+func TestWitnessesFromAddrBook(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lite_p2p")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	book := pex.NewAddrBook(filepath.Join(dir, "addrbook.json"), false)
+	src, err := p2p.NewNetAddressString(fmt.Sprintf("%s@127.0.0.1:26656",
+		p2p.PubKeyToID(ed25519.GenPrivKey().PubKey())))
+	require.NoError(t, err)
+
+	ids := make([]p2p.ID, 0, 5)
+	for i := 0; i < 5; i++ {
+		id := p2p.PubKeyToID(ed25519.GenPrivKey().PubKey())
+		addr, err := p2p.NewNetAddressString(fmt.Sprintf("%s@127.0.0.%d:26656", id, i+2))
+		require.NoError(t, err)
+		require.NoError(t, book.AddAddress(addr, src))
+		ids = append(ids, id)
+	}
+
+	r := NewReactor(nil, nil, nil)
+	assert.Len(t, WitnessesFromAddrBook(chainID, r, book, 3), 3)
+
+	witnesses := WitnessesFromAddrBook(chainID, r, book, 10, ids[0])
+	require.Len(t, witnesses, 4)
+	for _, w := range witnesses {
+		assert.NotEqual(t, ids[0], w.(*p2pProvider).addr.ID)
+	}
+}
@@ -0,0 +1,234 @@
+package p2p
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/p2p"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// LightBlockChannel exchanges signed headers, validator sets and evidence
+	// with light clients.
+	LightBlockChannel = byte(0x62)
+)
+
+// EvidencePool is the part of the evidence pool used to receive evidence
+// reported by light clients.
+type EvidencePool interface {
+	AddEvidence(types.Evidence) error
+}
+
+// Reactor serves signed headers and validator sets (light blocks) to light
+// clients, and receives evidence from them. The same reactor is used on the
+// light client side to request light blocks from full nodes (see New).
+type Reactor struct {
+	p2p.BaseReactor
+
+	// These are only set on full nodes. They are used to serve light blocks.
+	stateDB    dbm.DB
+	blockStore sm.BlockStoreRPC
+	evpool     EvidencePool
+
+	// pending requests by peer (light client side)
+	mtx     sync.Mutex
+	pending map[p2p.ID]chan *lightBlockResponseMessage
+}
+
+// NewReactor creates a new light block reactor. stateDB, blockStore and
+// evpool can be nil, in which case no light blocks are served and evidence is
+// ignored (e.g. when used by a light client).
+func NewReactor(stateDB dbm.DB, blockStore sm.BlockStoreRPC, evpool EvidencePool) *Reactor {
+	r := &Reactor{
+		stateDB:    stateDB,
+		blockStore: blockStore,
+		evpool:     evpool,
+		pending:    make(map[p2p.ID]chan *lightBlockResponseMessage),
+	}
+	r.BaseReactor = *p2p.NewBaseReactor("LightBlock", r)
+	return r
+}
+
+// GetChannels implements p2p.Reactor.
+func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
+	return []*p2p.ChannelDescriptor{
+		{
+			ID:                  LightBlockChannel,
+			Priority:            1,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: maxMsgSize,
+		},
+	}
+}
+
+// RemovePeer implements p2p.Reactor by dropping the pending request to the
+// peer, if any.
+func (r *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	delete(r.pending, peer.ID())
+}
+
+// Receive implements p2p.Reactor.
+func (r *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
+	if !r.IsRunning() {
+		return
+	}
+
+	msg, err := decodeMsg(msgBytes)
+	if err != nil {
+		r.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
+		r.Switch.StopPeerForError(src, err)
+		return
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		r.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
+		r.Switch.StopPeerForError(src, err)
+		return
+	}
+
+	switch msg := msg.(type) {
+	case *lightBlockRequestMessage:
+		r.respondWithLightBlock(msg, src)
+
+	case *lightBlockResponseMessage:
+		r.mtx.Lock()
+		ch, ok := r.pending[src.ID()]
+		r.mtx.Unlock()
+		if !ok {
+			r.Logger.Debug("Received unexpected light block", "height", msg.Height, "peer", src.ID())
+			return
+		}
+		select {
+		case ch <- msg:
+		default:
+			r.Logger.Debug("Dropping duplicate light block", "height", msg.Height, "peer", src.ID())
+		}
+
+	case *evidenceMessage:
+		if r.evpool == nil {
+			r.Logger.Debug("Received unexpected evidence", "peer", src.ID())
+			return
+		}
+		if err := r.evpool.AddEvidence(msg.Evidence); err != nil {
+			r.Logger.Error("Failed to add evidence", "evidence", msg.Evidence, "peer", src.ID(), "err", err)
+		}
+
+	default:
+		r.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+	}
+}
+
+// respondWithLightBlock loads the signed header and the validator set at the
+// requested height and sends them to the peer, or tells it they are missing.
+func (r *Reactor) respondWithLightBlock(msg *lightBlockRequestMessage, src p2p.Peer) {
+	if r.blockStore == nil || r.stateDB == nil {
+		r.Logger.Debug("Received unexpected light block request", "peer", src.ID())
+		return
+	}
+
+	resp, err := r.loadLightBlock(msg.Height)
+	if err != nil {
+		r.Logger.Error("Failed to load light block", "height", msg.Height, "err", err)
+		resp = &lightBlockResponseMessage{Height: msg.Height, Missing: true}
+	}
+	r.Logger.Debug("Sending light block", "height", resp.Height, "missing", resp.Missing, "peer", src.ID())
+	src.Send(LightBlockChannel, cdc.MustMarshalBinaryBare(resp))
+}
+
+// loadLightBlock loads the signed header and the validator set at the given
+// height (0 - the latest) from the block store and the state database.
+func (r *Reactor) loadLightBlock(height int64) (*lightBlockResponseMessage, error) {
+	storeHeight := r.blockStore.Height()
+	if height == 0 {
+		height = storeHeight
+	}
+	if height <= 0 || height < r.blockStore.Base() || height > storeHeight {
+		return &lightBlockResponseMessage{Height: height, Missing: true}, nil
+	}
+
+	meta := r.blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return &lightBlockResponseMessage{Height: height, Missing: true}, nil
+	}
+
+	// The commit for the latest block is not part of the chain yet, so the
+	// seen commit is used (as /commit does).
+	var commit *types.Commit
+	if height == storeHeight {
+		commit = r.blockStore.LoadSeenCommit(height)
+	} else {
+		commit = r.blockStore.LoadBlockCommit(height)
+	}
+	if commit == nil {
+		return &lightBlockResponseMessage{Height: height, Missing: true}, nil
+	}
+
+	vals, err := sm.LoadValidators(r.stateDB, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lightBlockResponseMessage{
+		Height:       height,
+		SignedHeader: &types.SignedHeader{Header: &meta.Header, Commit: commit},
+		ValidatorSet: vals,
+	}, nil
+}
+
+// requestLightBlock requests the light block at the given height (0 - the
+// latest) from the peer and waits for the response. Only one request per peer
+// can be in progress.
+func (r *Reactor) requestLightBlock(peer p2p.Peer, height int64,
+	timeout time.Duration) (*lightBlockResponseMessage, error) {
+
+	ch := make(chan *lightBlockResponseMessage, 1)
+	r.mtx.Lock()
+	if _, ok := r.pending[peer.ID()]; ok {
+		r.mtx.Unlock()
+		return nil, errors.Errorf("a request to peer %v is already in progress", peer.ID())
+	}
+	r.pending[peer.ID()] = ch
+	r.mtx.Unlock()
+
+	defer func() {
+		r.mtx.Lock()
+		delete(r.pending, peer.ID())
+		r.mtx.Unlock()
+	}()
+
+	r.Logger.Debug("Requesting light block", "height", height, "peer", peer.ID())
+	if !peer.Send(LightBlockChannel, cdc.MustMarshalBinaryBare(&lightBlockRequestMessage{Height: height})) {
+		return nil, errors.Errorf("failed to send a request to peer %v", peer.ID())
+	}
+
+	select {
+	case resp := <-ch:
+		if height != 0 && resp.Height != height {
+			return nil, errors.Errorf("peer %v responded with light block #%d, expected #%d",
+				peer.ID(), resp.Height, height)
+		}
+		return resp, nil
+	case <-time.After(timeout):
+		return nil, errors.Errorf("timed out waiting for light block #%d from peer %v", height, peer.ID())
+	case <-r.Quit():
+		return nil, errors.New("reactor stopped")
+	}
+}
+
+// reportEvidence sends the evidence to the peer.
+func (r *Reactor) reportEvidence(peer p2p.Peer, ev types.Evidence) error {
+	if !peer.Send(LightBlockChannel, cdc.MustMarshalBinaryBare(&evidenceMessage{Evidence: ev})) {
+		return errors.Errorf("failed to send evidence to peer %v", peer.ID())
+	}
+	return nil
+}
@@ -5,20 +5,38 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	service "github.com/tendermint/tendermint/libs/service"
 	lite "github.com/tendermint/tendermint/lite2"
+	"github.com/tendermint/tendermint/lite2/provider"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
 	"github.com/tendermint/tendermint/types"
 )
 
+const (
+	// maxPerPage is the maximum number of validators returned by a single
+	// /validators request.
+	maxPerPage = 100
+
+	// resultsTimeout is how long a Tx event waits for the next header, which
+	// contains the hash of the results, to become available.
+	resultsTimeout = 30 * time.Second
+	// resultsRetryInterval is how often the next header is requested.
+	resultsRetryInterval = 500 * time.Millisecond
+	// maxPendingEvents is the number of events of a subscription, which can
+	// wait to be verified. The subscription is dropped once it's exceeded.
+	maxPendingEvents = 100
+)
+
 // Client is an RPC client, which uses lite#Client to verify data (if it can be
 // proved!).
 type Client struct {
@@ -242,8 +260,49 @@ func (c *Client) Block(height *int64) (*ctypes.ResultBlock, error) {
 	return res, nil
 }
 
+// BlockResults calls rpcclient#BlockResults and then verifies the results of
+// the transactions against the LastResultsHash of the next header.
+//
+// NOTE: only the codes and the data of the results are hashed into
+// LastResultsHash, so the rest (events, logs, Begin/EndBlock responses) can
+// not be verified and is stripped from the returned results.
 func (c *Client) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
-	return c.next.BlockResults(height)
+	res, err := c.next.BlockResults(height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.Height <= 0 || height != nil && res.Height != *height {
+		return nil, errors.Errorf("invalid ResultBlockResults: %v", res)
+	}
+	for _, r := range res.TxsResults {
+		if r == nil {
+			return nil, errors.New("nil ResponseDeliverTx")
+		}
+	}
+
+	// Update the light client if we're behind.
+	// NOTE: LastResultsHash for height H is in header H+1.
+	h, err := c.updateLiteClientIfNeededTo(res.Height + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify results.
+	if rH, tH := types.NewResults(res.TxsResults).Hash(), h.LastResultsHash; !bytes.Equal(rH, tH) {
+		return nil, errors.Errorf("results %X does not match with trusted LastResultsHash %X",
+			rH, tH)
+	}
+
+	verified := &ctypes.ResultBlockResults{
+		Height:     res.Height,
+		TxsResults: make([]*abci.ResponseDeliverTx, len(res.TxsResults)),
+	}
+	for i, r := range res.TxsResults {
+		verified.TxsResults[i] = verifiedResult(r)
+	}
+	return verified, nil
 }
 
 func (c *Client) Commit(height *int64) (*ctypes.ResultCommit, error) {
@@ -272,44 +331,222 @@ func (c *Client) Commit(height *int64) (*ctypes.ResultCommit, error) {
 	return res, nil
 }
 
-// Tx calls rpcclient#Tx method and then verifies the proof if such was
-// requested.
+// Tx calls rpcclient#Tx method and then verifies the transaction and its
+// result (see verifyTx). The proof is always requested, as the transaction
+// can't be verified without it, but it's only returned if prove is true.
+//
+// NOTE: only the code and the data of the result can be verified, so the rest
+// is stripped from the returned result.
 func (c *Client) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
-	res, err := c.next.Tx(hash, prove)
-	if err != nil || !prove {
-		return res, err
+	res, err := c.next.Tx(hash, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil || !bytes.Equal(res.Hash, hash) {
+		return nil, errors.Errorf("ResultTx is not for the requested hash %X: %v", hash, res)
+	}
+	if err := c.verifyTx(res, make(map[int64]*ctypes.ResultBlockResults)); err != nil {
+		return nil, err
+	}
+	if !prove {
+		res.Proof = types.TxProof{}
+	}
+	return res, nil
+}
+
+// TxSearch calls rpcclient#TxSearch method and then verifies every transaction
+// and its result (see Tx).
+func (c *Client) TxSearch(
+	query string,
+	prove bool,
+	page, perPage int,
+	orderBy, cursor string,
+) (*ctypes.ResultTxSearch, error) {
+	res, err := c.next.TxSearch(query, true, page, perPage, orderBy, cursor)
+	if err != nil {
+		return nil, err
 	}
 
+	// the results are fetched once for all the txs of a height
+	results := make(map[int64]*ctypes.ResultBlockResults)
+	for _, tx := range res.Txs {
+		if err := c.verifyTx(tx, results); err != nil {
+			return nil, err
+		}
+		if !prove {
+			tx.Proof = types.TxProof{}
+		}
+	}
+	return res, nil
+}
+
+// verifyTx verifies the proof of the given transaction against the trusted
+// header, and its result against the verified results of the block, which are
+// cached by height in results. The result is stripped to its verified part.
+func (c *Client) verifyTx(res *ctypes.ResultTx, results map[int64]*ctypes.ResultBlockResults) error {
 	// Validate res.
-	if res.Height <= 0 {
-		return nil, errors.Errorf("invalid ResultTx: %v", res)
+	if res == nil || res.Height <= 0 {
+		return errors.Errorf("invalid ResultTx: %v", res)
+	}
+	if !bytes.Equal(res.Hash, res.Tx.Hash()) {
+		return errors.Errorf("ResultTx#Hash %X does not match with Tx %X", res.Hash, res.Tx.Hash())
+	}
+	if !bytes.Equal(res.Tx, res.Proof.Data) || res.Proof.Proof.Index != int(res.Index) {
+		return errors.Errorf("proof is not for tx %X", res.Hash)
 	}
 
 	// Update the light client if we're behind.
 	h, err := c.updateLiteClientIfNeededTo(res.Height)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Validate the proof.
-	return res, res.Proof.Validate(h.DataHash)
+	if err := res.Proof.Validate(h.DataHash); err != nil {
+		return err
+	}
+
+	// Verify the result.
+	blockResults, ok := results[res.Height]
+	if !ok {
+		blockResults, err = c.BlockResults(&res.Height)
+		if err != nil {
+			return errors.Wrapf(err, "BlockResults(#%d)", res.Height)
+		}
+		results[res.Height] = blockResults
+	}
+	if int(res.Index) >= len(blockResults.TxsResults) {
+		return errors.Errorf("no result for tx %X in block #%d", res.Hash, res.Height)
+	}
+	rH, tH := resultBytes(&res.TxResult), resultBytes(blockResults.TxsResults[res.Index])
+	if !bytes.Equal(rH, tH) {
+		return errors.Errorf("result %X does not match with trusted result %X", rH, tH)
+	}
+	res.TxResult = *verifiedResult(&res.TxResult)
+	return nil
 }
 
-func (c *Client) TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
-	return c.next.TxSearch(query, prove, page, perPage)
+// BlockSearch returns an error: the heights of the blocks matching the query
+// can't be verified, since the events of the blocks aren't hashed into the
+// headers.
+func (c *Client) BlockSearch(query string, page, perPage int) (*ctypes.ResultBlockSearch, error) {
+	return nil, errors.New("block_search can't be verified")
 }
 
+// Validators calls rpcclient#Validators and then verifies every validator
+// returned against the full validator set at that height, which is checked
+// against the trusted header.
 func (c *Client) Validators(height *int64, page, perPage int) (*ctypes.ResultValidators, error) {
-	return c.next.Validators(height, page, perPage)
+	res, err := c.next.Validators(height, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.BlockHeight <= 0 || height != nil && res.BlockHeight != *height {
+		return nil, errors.Errorf("invalid ResultValidators: %v", res)
+	}
+
+	vals, err := c.validatorSetAt(res.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify each of the validators.
+	for _, v := range res.Validators {
+		if v == nil {
+			return nil, errors.New("nil Validator")
+		}
+		_, tv := vals.GetByAddress(v.Address)
+		if tv == nil || !bytes.Equal(v.Bytes(), tv.Bytes()) {
+			return nil, errors.Errorf("validator %X does not match with trusted validator set", v.Address)
+		}
+	}
+
+	return res, nil
+}
+
+// validatorSetAt fetches the whole validator set at the given height and
+// verifies it against the trusted header.
+func (c *Client) validatorSetAt(height int64) (*types.ValidatorSet, error) {
+	// Update the light client if we're behind.
+	// NOTE: the validators for height H are the next validators of header H-1
+	// (header H does not exist yet if H is the latest height).
+	var valsHash []byte
+	if height > 1 {
+		h, err := c.updateLiteClientIfNeededTo(height - 1)
+		if err != nil {
+			return nil, err
+		}
+		valsHash = h.NextValidatorsHash
+	} else {
+		h, err := c.updateLiteClientIfNeededTo(height)
+		if err != nil {
+			return nil, err
+		}
+		valsHash = h.ValidatorsHash
+	}
+
+	vals := make([]*types.Validator, 0)
+	for page := 1; ; page++ {
+		res, err := c.next.Validators(&height, page, maxPerPage)
+		if err != nil {
+			return nil, err
+		}
+		if res.BlockHeight != height {
+			return nil, errors.Errorf("invalid ResultValidators: %v", res)
+		}
+		vals = append(vals, res.Validators...)
+
+		valSet := &types.ValidatorSet{Validators: vals}
+		if bytes.Equal(valSet.Hash(), valsHash) {
+			return valSet, nil
+		}
+		if len(res.Validators) < maxPerPage {
+			break
+		}
+	}
+
+	return nil, errors.Errorf("validator set at height %d does not match with trusted validators hash %X",
+		height, valsHash)
 }
 
 func (c *Client) BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return c.next.BroadcastEvidence(ev)
 }
 
+// Subscribe calls rpcclient#Subscribe and then verifies every event before
+// sending it to out. Only NewBlock, NewBlockHeader and Tx events can be
+// verified, other events (and the ones failing the verification) are dropped.
+//
+// The events are verified off the loop receiving them, one at a time and in
+// the order they were received, so that the light client moves forward. Tx
+// events are delayed until the next header, which contains the hash of the
+// results, is available (see BlockResults). The block and the results are
+// fetched once for all the Tx events of a height. If more than
+// maxPendingEvents events wait to be verified, the subscription is dropped:
+// the channel is closed once the pending events are sent.
+//
+// NOTE: what can't be verified against the headers is stripped from the
+// events: the Begin/EndBlock responses and NumTxs of the block events, and
+// everything but the code and the data of the tx results. The Events map only
+// holds the event type, and the hash and height of the txs.
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
-	return c.next.Subscribe(ctx, subscriber, query, outCapacity...)
+
+	in, err := c.next.Subscribe(ctx, subscriber, query, outCapacity...)
+	if err != nil {
+		return nil, err
+	}
+
+	verified := make(chan ctypes.ResultEvent, cap(in))
+	go c.verifyEvents(in, verified, func() {
+		if err := c.next.Unsubscribe(context.Background(), subscriber, query); err != nil {
+			c.Logger.Error("Failed to unsubscribe", "subscriber", subscriber, "query", query, "err", err)
+		}
+	})
+	return verified, nil
 }
 
 func (c *Client) Unsubscribe(ctx context.Context, subscriber, query string) error {
@@ -326,7 +563,13 @@ func (c *Client) updateLiteClientIfNeededTo(height int64) (*types.SignedHeader,
 		return nil, errors.Wrap(err, "LastTrustedHeight")
 	}
 
-	if lastTrustedHeight < height {
+	firstTrustedHeight, err := c.lc.FirstTrustedHeight()
+	if err != nil {
+		return nil, errors.Wrap(err, "FirstTrustedHeight")
+	}
+
+	// verify forwards or backwards
+	if lastTrustedHeight < height || height < firstTrustedHeight {
 		return c.lc.VerifyHeaderAtHeight(height, time.Now())
 	}
 
@@ -337,15 +580,228 @@ func (c *Client) updateLiteClientIfNeededTo(height int64) (*types.SignedHeader,
 	return h, nil
 }
 
+// verifyEvents receives events from in until it is closed or the client is
+// stopped, and queues them for verifyPendingEvents. If the queue is full, the
+// subscription is dropped with unsubscribe.
+func (c *Client) verifyEvents(in <-chan ctypes.ResultEvent, out chan<- ctypes.ResultEvent, unsubscribe func()) {
+	pending := make(chan ctypes.ResultEvent, maxPendingEvents)
+	go c.verifyPendingEvents(pending, out)
+	defer close(pending)
+
+	for {
+		select {
+		case resultEvent, ok := <-in:
+			if !ok {
+				return
+			}
+			select {
+			case pending <- resultEvent:
+			default:
+				c.Logger.Error("Dropping subscription, which fell behind verifying its events",
+					"query", resultEvent.Query, "pending", maxPendingEvents)
+				unsubscribe()
+				return
+			}
+		case <-c.Quit():
+			return
+		}
+	}
+}
+
+// verifyPendingEvents verifies the events one at a time, and closes out once
+// they are all sent (or dropped). The block and the results of a height are
+// kept for the following Tx events of the same height.
+func (c *Client) verifyPendingEvents(pending <-chan ctypes.ResultEvent, out chan<- ctypes.ResultEvent) {
+	defer close(out)
+
+	var ch *cachedHeight
+	for resultEvent := range pending {
+		if data, ok := resultEvent.Data.(types.EventDataTx); ok && (ch == nil || ch.height != data.Height) {
+			ch = &cachedHeight{height: data.Height}
+		}
+		c.verifyAndSendEvent(resultEvent, ch, out)
+	}
+}
+
+func (c *Client) verifyAndSendEvent(resultEvent ctypes.ResultEvent, ch *cachedHeight,
+	out chan<- ctypes.ResultEvent) {
+	select {
+	case <-c.Quit():
+		return
+	default:
+	}
+
+	verified, err := c.verifyEvent(resultEvent, ch)
+	if err != nil {
+		c.Logger.Error("Dropping unverified event", "query", resultEvent.Query, "err", err)
+		return
+	}
+	select {
+	case out <- verified:
+	case <-c.Quit():
+	}
+}
+
+// verifyEvent verifies the event's data against the trusted headers and
+// returns the event with the verified data only.
+func (c *Client) verifyEvent(resultEvent ctypes.ResultEvent, ch *cachedHeight) (ctypes.ResultEvent, error) {
+	verified := ctypes.ResultEvent{Query: resultEvent.Query}
+
+	switch data := resultEvent.Data.(type) {
+	case types.EventDataNewBlock:
+		if err := c.verifyBlock(data.Block); err != nil {
+			return verified, err
+		}
+		verified.Data = types.EventDataNewBlock{Block: data.Block}
+		verified.Events = map[string][]string{types.EventTypeKey: {types.EventNewBlock}}
+	case types.EventDataNewBlockHeader:
+		h, err := c.updateLiteClientIfNeededTo(data.Header.Height)
+		if err != nil {
+			return verified, err
+		}
+		if hH, tH := data.Header.Hash(), h.Hash(); !bytes.Equal(hH, tH) {
+			return verified, errors.Errorf("Header %X does not match with trusted header %X",
+				hH, tH)
+		}
+		verified.Data = types.EventDataNewBlockHeader{Header: data.Header}
+		verified.Events = map[string][]string{types.EventTypeKey: {types.EventNewBlockHeader}}
+	case types.EventDataTx:
+		if err := c.verifyTxResult(data.TxResult, ch); err != nil {
+			return verified, err
+		}
+		txResult := data.TxResult
+		txResult.Result = *verifiedResult(&data.Result)
+		verified.Data = types.EventDataTx{TxResult: txResult}
+		verified.Events = map[string][]string{
+			types.EventTypeKey: {types.EventTx},
+			types.TxHashKey:    {fmt.Sprintf("%X", txResult.Tx.Hash())},
+			types.TxHeightKey:  {fmt.Sprintf("%d", txResult.Height)},
+		}
+	default:
+		return verified, errors.Errorf("can't verify %T event", resultEvent.Data)
+	}
+
+	return verified, nil
+}
+
+// verifyBlock verifies the block against the trusted header.
+func (c *Client) verifyBlock(block *types.Block) error {
+	if err := block.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Update the light client if we're behind.
+	h, err := c.updateLiteClientIfNeededTo(block.Height)
+	if err != nil {
+		return err
+	}
+
+	// Verify block.
+	if bH, tH := block.Hash(), h.Hash(); !bytes.Equal(bH, tH) {
+		return errors.Errorf("Block#Header %X does not match with trusted header %X",
+			bH, tH)
+	}
+	return nil
+}
+
+// verifyTxResult verifies the transaction is included in the trusted block
+// and its result matches the trusted results of the block, which are taken
+// from the cached height.
+func (c *Client) verifyTxResult(txResult types.TxResult, ch *cachedHeight) error {
+	if txResult.Height <= 0 {
+		return errors.Errorf("invalid TxResult: %v", txResult)
+	}
+
+	// Verify the transaction.
+	block, err := ch.getBlock(c)
+	if err != nil {
+		return err
+	}
+	if int(txResult.Index) >= len(block.Block.Txs) ||
+		!bytes.Equal(block.Block.Txs[txResult.Index], txResult.Tx) {
+		return errors.Errorf("tx %X is not in block #%d at index %d",
+			txResult.Tx.Hash(), txResult.Height, txResult.Index)
+	}
+
+	// Verify the result.
+	results, err := ch.getResults(c)
+	if err != nil {
+		return err
+	}
+	if int(txResult.Index) >= len(results.TxsResults) {
+		return errors.Errorf("no result for tx %X in block #%d", txResult.Tx.Hash(), txResult.Height)
+	}
+	rH, tH := resultBytes(&txResult.Result), resultBytes(results.TxsResults[txResult.Index])
+	if !bytes.Equal(rH, tH) {
+		return errors.Errorf("result %X does not match with trusted result %X", rH, tH)
+	}
+	return nil
+}
+
+// cachedHeight holds the verified block and results of a height, which has
+// Tx events.
+type cachedHeight struct {
+	height int64
+
+	blockOnce sync.Once
+	block     *ctypes.ResultBlock
+	blockErr  error
+
+	resultsOnce sync.Once
+	results     *ctypes.ResultBlockResults
+	resultsErr  error
+}
+
+func (ch *cachedHeight) getBlock(c *Client) (*ctypes.ResultBlock, error) {
+	ch.blockOnce.Do(func() {
+		ch.block, ch.blockErr = c.Block(&ch.height)
+	})
+	return ch.block, ch.blockErr
+}
+
+func (ch *cachedHeight) getResults(c *Client) (*ctypes.ResultBlockResults, error) {
+	ch.resultsOnce.Do(func() {
+		ch.results, ch.resultsErr = c.waitForBlockResults(ch.height)
+	})
+	return ch.results, ch.resultsErr
+}
+
+// waitForBlockResults calls BlockResults until the header following the
+// given height becomes available or resultsTimeout expires.
+func (c *Client) waitForBlockResults(height int64) (*ctypes.ResultBlockResults, error) {
+	deadline := time.Now().Add(resultsTimeout)
+	for {
+		res, err := c.BlockResults(&height)
+		if errors.Cause(err) != provider.ErrSignedHeaderNotFound || time.Now().After(deadline) {
+			return res, err
+		}
+
+		select {
+		case <-time.After(resultsRetryInterval):
+		case <-c.Quit():
+			return nil, errors.New("client stopped")
+		}
+	}
+}
+
+func resultBytes(r *abci.ResponseDeliverTx) []byte {
+	return types.NewResultFromResponse(r).Bytes()
+}
+
+// verifiedResult returns the part of the result, which is hashed into
+// LastResultsHash.
+func verifiedResult(r *abci.ResponseDeliverTx) *abci.ResponseDeliverTx {
+	return &abci.ResponseDeliverTx{Code: r.Code, Data: r.Data}
+}
+
 func (c *Client) RegisterOpDecoder(typ string, dec merkle.OpDecoder) {
 	c.prt.RegisterOpDecoder(typ, dec)
 }
 
 // SubscribeWS subscribes for events using the given query and remote address as
-// a subscriber, but does not verify responses (UNSAFE)!
-// TODO: verify data
+// a subscriber. Events are verified before being sent (see Subscribe).
 func (c *Client) SubscribeWS(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
-	out, err := c.next.Subscribe(context.Background(), ctx.RemoteAddr(), query)
+	out, err := c.Subscribe(context.Background(), ctx.RemoteAddr(), query)
 	if err != nil {
 		return nil, err
 	}
@@ -353,9 +809,10 @@ func (c *Client) SubscribeWS(ctx *rpctypes.Context, query string) (*ctypes.Resul
 	go func() {
 		for {
 			select {
-			case resultEvent := <-out:
-				// We should have a switch here that performs a validation
-				// depending on the event's type.
+			case resultEvent, ok := <-out:
+				if !ok {
+					return
+				}
 				ctx.WSConn.TryWriteRPCResponse(
 					rpctypes.NewRPCSuccessResponse(
 						ctx.WSConn.Codec(),
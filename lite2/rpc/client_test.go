@@ -0,0 +1,406 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	lite "github.com/tendermint/tendermint/lite2"
+	mockp "github.com/tendermint/tendermint/lite2/provider/mock"
+	dbs "github.com/tendermint/tendermint/lite2/store/db"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+)
+
+const chainID = "test"
+
+// mockNode serves a chain of blocks. It can be changed to return malicious
+// responses.
+type mockNode struct {
+	rpcclient.Client
+
+	blocks   map[int64]*types.Block
+	blockIDs map[int64]types.BlockID
+	results  map[int64][]*abci.ResponseDeliverTx
+	vals     *types.ValidatorSet
+	txs      []*ctypes.ResultTx
+	events   chan ctypes.ResultEvent
+	// unsubscribed receives the queries unsubscribed from
+	unsubscribed chan string
+
+	// heightDelta is added to the heights of the responses
+	heightDelta int64
+}
+
+func (n *mockNode) IsRunning() bool { return true }
+func (n *mockNode) Stop() error     { return nil }
+
+func (n *mockNode) Block(height *int64) (*ctypes.ResultBlock, error) {
+	block, ok := n.blocks[*height]
+	if !ok {
+		return nil, errors.Errorf("no block #%d", *height)
+	}
+	return &ctypes.ResultBlock{BlockID: n.blockIDs[*height], Block: block}, nil
+}
+
+func (n *mockNode) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	results, ok := n.results[*height]
+	if !ok {
+		return nil, errors.Errorf("no results #%d", *height)
+	}
+	return &ctypes.ResultBlockResults{Height: *height + n.heightDelta, TxsResults: results}, nil
+}
+
+func (n *mockNode) Validators(height *int64, page, perPage int) (*ctypes.ResultValidators, error) {
+	skip := (page - 1) * perPage
+	end := skip + perPage
+	if end > n.vals.Size() {
+		end = n.vals.Size()
+	}
+	return &ctypes.ResultValidators{BlockHeight: *height + n.heightDelta, Validators: n.vals.Validators[skip:end]}, nil
+}
+
+func (n *mockNode) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	for _, tx := range n.txs {
+		if bytes.Equal(tx.Hash, hash) {
+			res := *tx
+			return &res, nil
+		}
+	}
+	return nil, errors.Errorf("no tx %X", hash)
+}
+
+func (n *mockNode) TxSearch(query string, prove bool, page, perPage int,
+	orderBy, cursor string) (*ctypes.ResultTxSearch, error) {
+	txs := make([]*ctypes.ResultTx, len(n.txs))
+	for i, tx := range n.txs {
+		res := *tx
+		txs[i] = &res
+	}
+	return &ctypes.ResultTxSearch{Txs: txs, TotalCount: len(txs)}, nil
+}
+
+func (n *mockNode) Subscribe(ctx context.Context, subscriber, query string,
+	outCapacity ...int) (<-chan ctypes.ResultEvent, error) {
+	return n.events, nil
+}
+
+func (n *mockNode) Unsubscribe(ctx context.Context, subscriber, query string) error {
+	n.unsubscribed <- query
+	return nil
+}
+
+// newTestClient creates a chain of 4 blocks (with 2 txs in block #2 and 1 in
+// block #4, whose results can't be verified without block #5), a node
+// serving it and a client, which trusts block #1.
+func newTestClient(t *testing.T) (*Client, *mockNode) {
+	vals, privVals := types.RandValidatorSet(4, 10)
+	node := &mockNode{
+		blocks:   make(map[int64]*types.Block),
+		blockIDs: make(map[int64]types.BlockID),
+		results:  make(map[int64][]*abci.ResponseDeliverTx),
+		vals:     vals,
+		events:   make(chan ctypes.ResultEvent, 10),
+
+		unsubscribed: make(chan string, 1),
+	}
+
+	var (
+		headers     = make(map[int64]*types.SignedHeader)
+		valSets     = make(map[int64]*types.ValidatorSet)
+		lastCommit  *types.Commit
+		lastBlockID types.BlockID
+		bTime       = time.Now().Add(-time.Hour)
+	)
+	for height := int64(1); height <= 4; height++ {
+		var txs types.Txs
+		results := []*abci.ResponseDeliverTx{}
+		switch height {
+		case 2:
+			txs = types.Txs{types.Tx("foo=bar"), types.Tx("baz=qux")}
+			results = []*abci.ResponseDeliverTx{{Code: 0, Data: []byte("ok")}, {Code: 1, Data: []byte("fail")}}
+		case 4:
+			txs = types.Txs{types.Tx("foo=baz")}
+			results = []*abci.ResponseDeliverTx{{Code: 0}}
+		}
+
+		block := types.MakeBlock(height, txs, lastCommit, nil)
+		block.Header.Populate(version.Consensus{Block: version.BlockProtocol}, chainID,
+			bTime.Add(time.Duration(height)*time.Minute), lastBlockID, vals.Hash(), vals.Hash(),
+			tmhash.Sum([]byte("consensus")), []byte("app_hash"), types.NewResults(node.results[height-1]).Hash(),
+			vals.Validators[0].Address)
+		blockID := types.BlockID{
+			Hash:        block.Header.Hash(),
+			PartsHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+		}
+		voteSet := types.NewVoteSet(chainID, height, 0, types.PrecommitType, vals)
+		commit, err := types.MakeCommit(blockID, height, 0, voteSet, privVals)
+		require.NoError(t, err)
+
+		node.blocks[height] = block
+		node.blockIDs[height] = blockID
+		node.results[height] = results
+		headers[height] = &types.SignedHeader{Header: &block.Header, Commit: commit}
+		valSets[height] = vals
+		lastCommit, lastBlockID = commit, blockID
+	}
+	// the next vals of the latest block
+	valSets[5] = vals
+
+	block := node.blocks[2]
+	for i, tx := range block.Txs {
+		node.txs = append(node.txs, &ctypes.ResultTx{
+			Hash:     tx.Hash(),
+			Height:   2,
+			Index:    uint32(i),
+			TxResult: *node.results[2][i],
+			Tx:       tx,
+			Proof:    block.Txs.Proof(i),
+		})
+	}
+
+	lc, err := lite.NewClient(
+		chainID,
+		lite.TrustOptions{
+			Period: 24 * time.Hour,
+			Height: 1,
+			Hash:   headers[1].Hash(),
+		},
+		mockp.New(chainID, headers, valSets),
+		dbs.New(dbm.NewMemDB(), chainID),
+	)
+	require.NoError(t, err)
+
+	return NewClient(node, lc), node
+}
+
+func TestClient_BlockResults(t *testing.T) {
+	c, node := newTestClient(t)
+
+	height := int64(2)
+	res, err := c.BlockResults(&height)
+	require.NoError(t, err)
+	assert.Len(t, res.TxsResults, 2)
+
+	// the unverifiable fields are stripped
+	node.results[2][0].Log = "unverified"
+	node.results[2][0].Events = []abci.Event{{Type: "transfer"}}
+	res, err = c.BlockResults(&height)
+	require.NoError(t, err)
+	assert.Equal(t, &abci.ResponseDeliverTx{Code: 0, Data: []byte("ok")}, res.TxsResults[0])
+
+	// results of another height
+	node.heightDelta = 1
+	_, err = c.BlockResults(&height)
+	assert.Error(t, err)
+	node.heightDelta = 0
+
+	// results of the latest block can't be verified yet
+	height = 4
+	_, err = c.BlockResults(&height)
+	assert.Error(t, err)
+
+	// fake results
+	node.results[2][1] = &abci.ResponseDeliverTx{Code: 0, Data: []byte("ok")}
+	height = 2
+	_, err = c.BlockResults(&height)
+	assert.Error(t, err)
+}
+
+func TestClient_Validators(t *testing.T) {
+	c, node := newTestClient(t)
+
+	height := int64(3)
+	res, err := c.Validators(&height, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, node.vals.Validators[2:], res.Validators)
+
+	// validators of another height
+	node.heightDelta = -1
+	_, err = c.Validators(&height, 1, 2)
+	assert.Error(t, err)
+	node.heightDelta = 0
+
+	// fake validators
+	node.vals, _ = types.RandValidatorSet(4, 10)
+	_, err = c.Validators(&height, 1, 2)
+	assert.Error(t, err)
+}
+
+func TestClient_Tx(t *testing.T) {
+	c, node := newTestClient(t)
+	hash := node.txs[0].Hash
+
+	res, err := c.Tx(hash, true)
+	require.NoError(t, err)
+	assert.Equal(t, node.blocks[2].Txs[0], res.Tx)
+	assert.NotEmpty(t, res.Proof.Data)
+
+	// the proof is verified, but not returned
+	res, err = c.Tx(hash, false)
+	require.NoError(t, err)
+	assert.Empty(t, res.Proof.Data)
+
+	// the unverifiable fields are stripped
+	node.txs[0].TxResult.Log = "unverified"
+	res, err = c.Tx(hash, false)
+	require.NoError(t, err)
+	assert.Equal(t, abci.ResponseDeliverTx{Code: 0, Data: []byte("ok")}, res.TxResult)
+
+	// another tx
+	node.txs = node.txs[1:]
+	node.txs[0].Hash = hash
+	_, err = c.Tx(hash, false)
+	assert.Error(t, err)
+}
+
+func TestClient_TxSearch(t *testing.T) {
+	c, node := newTestClient(t)
+
+	res, err := c.TxSearch("tx.height=2", true, 1, 30, "", "")
+	require.NoError(t, err)
+	assert.Len(t, res.Txs, 2)
+
+	// a fake result
+	node.txs[1].TxResult = abci.ResponseDeliverTx{Code: 0, Data: []byte("ok")}
+	_, err = c.TxSearch("tx.height=2", false, 1, 30, "", "")
+	assert.Error(t, err)
+
+	// a tx, which is not in the block
+	node.txs[1].Tx = types.Tx("fake=tx")
+	node.txs[1].Hash = node.txs[1].Tx.Hash()
+	_, err = c.TxSearch("tx.height=2", true, 1, 30, "", "")
+	assert.Error(t, err)
+
+	// the proof is for another tx
+	node.txs[1].Proof.Data = node.txs[1].Tx
+	_, err = c.TxSearch("tx.height=2", true, 1, 30, "", "")
+	assert.Error(t, err)
+
+	// without proofs, txs are verified all the same
+	_, err = c.TxSearch("tx.height=2", false, 1, 30, "", "")
+	assert.Error(t, err)
+
+	// the matches of block_search can't be verified
+	_, err = c.BlockSearch("block.height > 1", 1, 30)
+	assert.Error(t, err)
+}
+
+func TestClient_Subscribe(t *testing.T) {
+	c, node := newTestClient(t)
+
+	out, err := c.Subscribe(context.Background(), "test", "tm.event='NewBlock'")
+	require.NoError(t, err)
+
+	fakeBlock := types.MakeBlock(3, nil, node.blocks[3].LastCommit, nil)
+	fakeBlock.Header.Populate(node.blocks[3].Version, chainID, node.blocks[3].Time, node.blocks[3].LastBlockID,
+		node.vals.Hash(), node.vals.Hash(), node.blocks[3].ConsensusHash, []byte("fake_app_hash"),
+		node.blocks[3].LastResultsHash, node.blocks[3].ProposerAddress)
+
+	txResult := types.TxResult{Height: 2, Index: 1, Tx: node.blocks[2].Txs[1], Result: *node.results[2][1]}
+	fakeTxResult := txResult
+	fakeTxResult.Result = abci.ResponseDeliverTx{Code: 0, Data: []byte("ok")}
+	// the unverifiable fields are stripped
+	unverifiedTxResult := txResult
+	unverifiedTxResult.Result.Log = "unverified"
+	unverifiedTxResult.Result.Events = []abci.Event{{Type: "transfer"}}
+	unverifiedBlock := types.EventDataNewBlock{
+		Block:          node.blocks[3],
+		ResultEndBlock: abci.ResponseEndBlock{Events: []abci.Event{{Type: "transfer"}}},
+	}
+
+	events := []struct {
+		data     types.TMEventData
+		verified types.TMEventData
+	}{
+		{types.EventDataNewBlock{Block: node.blocks[2]}, types.EventDataNewBlock{Block: node.blocks[2]}},
+		{types.EventDataNewBlock{Block: fakeBlock}, nil},
+		{types.EventDataNewBlockHeader{Header: node.blocks[3].Header, NumTxs: 3},
+			types.EventDataNewBlockHeader{Header: node.blocks[3].Header}},
+		{types.EventDataNewBlockHeader{Header: fakeBlock.Header}, nil},
+		{types.EventDataTx{TxResult: txResult}, types.EventDataTx{TxResult: txResult}},
+		{types.EventDataTx{TxResult: fakeTxResult}, nil},
+		{types.EventDataNewRound{Height: 4}, nil},
+		{types.EventDataTx{TxResult: unverifiedTxResult}, types.EventDataTx{TxResult: txResult}},
+		{unverifiedBlock, types.EventDataNewBlock{Block: node.blocks[3]}},
+	}
+	for _, ev := range events {
+		node.events <- ctypes.ResultEvent{Data: ev.data, Events: map[string][]string{"transfer.sender": {"unverified"}}}
+	}
+	close(node.events)
+
+	for _, ev := range events {
+		if ev.verified == nil {
+			continue
+		}
+		select {
+		case resultEvent := <-out:
+			assert.Equal(t, ev.verified, resultEvent.Data)
+			assert.NotContains(t, resultEvent.Events, "transfer.sender")
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %T", ev.data)
+		}
+	}
+
+	// the unverified events are dropped
+	select {
+	case resultEvent, ok := <-out:
+		assert.False(t, ok, "unexpected event %v", resultEvent)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for out to be closed")
+	}
+}
+
+func TestClient_SubscribeDoesNotBlock(t *testing.T) {
+	c, node := newTestClient(t)
+	require.NoError(t, c.Start())
+
+	out, err := c.Subscribe(context.Background(), "test", "tm.event='Tx'")
+	require.NoError(t, err)
+
+	// the results of block #4 are not available until the next header is
+	txResult := types.TxResult{Height: 4, Index: 0, Tx: node.blocks[4].Txs[0]}
+	node.events <- ctypes.ResultEvent{Data: types.EventDataTx{TxResult: txResult}}
+
+	// the following events are still received, while the first one waits
+	for i := 0; i < maxPendingEvents; i++ {
+		select {
+		case node.events <- ctypes.ResultEvent{Data: types.EventDataNewBlock{Block: node.blocks[2]}}:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out sending the events")
+		}
+	}
+	select {
+	case resultEvent := <-out:
+		t.Fatalf("unexpected event %v before the first one", resultEvent)
+	default:
+	}
+
+	// until the subscription falls too far behind, and is dropped
+	node.events <- ctypes.ResultEvent{Data: types.EventDataNewBlock{Block: node.blocks[2]}}
+	select {
+	case query := <-node.unsubscribed:
+		assert.Equal(t, "tm.event='Tx'", query)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the subscription to be dropped")
+	}
+
+	require.NoError(t, c.Stop())
+	select {
+	case _, ok := <-out:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for out to be closed")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"sync"
 
 	"github.com/tendermint/go-amino"
 	dbm "github.com/tendermint/tm-db"
@@ -17,6 +18,11 @@ import (
 type dbs struct {
 	db     dbm.DB
 	prefix string
+	pinned map[int64]struct{}
+
+	// guards size, which is also persisted to the db
+	mtx  sync.RWMutex
+	size int
 
 	cdc *amino.Codec
 }
@@ -25,10 +31,20 @@ type dbs struct {
 // want to use one DB with many light clients).
 //
 // Objects are marshalled using amino (github.com/tendermint/go-amino)
-func New(db dbm.DB, prefix string) store.Store {
+//
+// Prune removes the oldest headers, except the ones at the pinned heights
+// (e.g. the trust root and checkpoints), so the store can hold more headers
+// than the size given to Prune if too many of them are pinned. The store must
+// not be shared between processes (see store/sql for that).
+func New(db dbm.DB, prefix string, pinned ...int64) store.Store {
 	cdc := amino.NewCodec()
 	cryptoAmino.RegisterAmino(cdc)
-	return &dbs{db: db, prefix: prefix, cdc: cdc}
+	s := &dbs{db: db, prefix: prefix, pinned: make(map[int64]struct{}, len(pinned)), cdc: cdc}
+	for _, height := range pinned {
+		s.pinned[height] = struct{}{}
+	}
+	s.size = s.loadSize()
+	return s
 }
 
 // SaveSignedHeaderAndNextValidatorSet persists SignedHeader and ValidatorSet
@@ -38,18 +54,33 @@ func (s *dbs) SaveSignedHeaderAndNextValidatorSet(sh *types.SignedHeader, valSet
 		panic("negative or zero height")
 	}
 
-	// TODO: batch
-	bz, err := s.cdc.MarshalBinaryLengthPrefixed(sh)
+	shBz, err := s.cdc.MarshalBinaryLengthPrefixed(sh)
 	if err != nil {
 		return err
 	}
-	s.db.Set(s.shKey(sh.Height), bz)
 
-	bz, err = s.cdc.MarshalBinaryLengthPrefixed(valSet)
+	vsBz, err := s.cdc.MarshalBinaryLengthPrefixed(valSet)
 	if err != nil {
 		return err
 	}
-	s.db.Set(s.vsKey(sh.Height+1), bz)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	size := s.size
+	if !s.has(sh.Height) {
+		size++
+	}
+
+	b := s.db.NewBatch()
+	defer b.Close()
+	b.Set(s.shKey(sh.Height), shBz)
+	b.Set(s.vsKey(sh.Height+1), vsBz)
+	b.Set(s.sizeKey(), s.cdc.MustMarshalBinaryLengthPrefixed(int64(size)))
+	if err := b.WriteSync(); err != nil {
+		return err
+	}
+	s.size = size
 
 	return nil
 }
@@ -61,9 +92,23 @@ func (s *dbs) DeleteSignedHeaderAndNextValidatorSet(height int64) error {
 		panic("negative or zero height")
 	}
 
-	// TODO: batch
-	s.db.Delete(s.shKey(height))
-	s.db.Delete(s.vsKey(height + 1))
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	size := s.size
+	if s.has(height) {
+		size--
+	}
+
+	b := s.db.NewBatch()
+	defer b.Close()
+	b.Delete(s.shKey(height))
+	b.Delete(s.vsKey(height + 1))
+	b.Set(s.sizeKey(), s.cdc.MustMarshalBinaryLengthPrefixed(int64(size)))
+	if err := b.WriteSync(); err != nil {
+		return err
+	}
+	s.size = size
 
 	return nil
 }
@@ -152,6 +197,112 @@ func (s *dbs) FirstSignedHeaderHeight() (int64, error) {
 	return -1, nil
 }
 
+// Size returns the number of SignedHeader & ValidatorSet pairs stored.
+func (s *dbs) Size() (int, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.size, nil
+}
+
+// Prune removes the oldest SignedHeader & ValidatorSet pairs, which are not
+// pinned, until at most size of them are left.
+func (s *dbs) Prune(size int) error {
+	if size <= 0 {
+		panic("negative or zero size")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.size <= size {
+		return nil
+	}
+
+	lastHeight, err := s.LastSignedHeaderHeight()
+	if err != nil {
+		return err
+	}
+
+	itr, err := s.db.Iterator(
+		s.shKey(1),
+		append(s.shKey(1<<63-1), byte(0x00)),
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	b := s.db.NewBatch()
+	defer b.Close()
+
+	pruned := 0
+	for ; itr.Valid() && s.size-pruned > size; itr.Next() {
+		_, height, ok := parseShKey(itr.Key())
+		if !ok {
+			continue
+		}
+		if height == lastHeight {
+			break
+		}
+		if _, ok := s.pinned[height]; ok {
+			continue
+		}
+		b.Delete(s.shKey(height))
+		b.Delete(s.vsKey(height + 1))
+		pruned++
+	}
+	b.Set(s.sizeKey(), s.cdc.MustMarshalBinaryLengthPrefixed(int64(s.size-pruned)))
+	if err := b.WriteSync(); err != nil {
+		return err
+	}
+	s.size -= pruned
+
+	return nil
+}
+
+func (s *dbs) has(height int64) bool {
+	ok, err := s.db.Has(s.shKey(height))
+	if err != nil {
+		panic(err)
+	}
+	return ok
+}
+
+// loadSize loads the size from the db. If it was not saved yet (the store
+// was created by an older version), the headers are counted.
+func (s *dbs) loadSize() int {
+	bz, err := s.db.Get(s.sizeKey())
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) > 0 {
+		var size int64
+		s.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &size)
+		return int(size)
+	}
+
+	itr, err := s.db.Iterator(
+		s.shKey(1),
+		append(s.shKey(1<<63-1), byte(0x00)),
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer itr.Close()
+
+	size := 0
+	for ; itr.Valid(); itr.Next() {
+		if _, _, ok := parseShKey(itr.Key()); ok {
+			size++
+		}
+	}
+	return size
+}
+
+func (s *dbs) sizeKey() []byte {
+	return []byte(fmt.Sprintf("size/%s", s.prefix))
+}
+
 func (s *dbs) shKey(height int64) []byte {
 	return []byte(fmt.Sprintf("sh/%s/%020d", s.prefix, height))
 }
@@ -74,3 +74,96 @@ func Test_SaveSignedHeaderAndNextValidatorSet(t *testing.T) {
 	require.Error(t, err)
 	assert.Nil(t, valSet)
 }
+
+func Test_Prune(t *testing.T) {
+	db := dbm.NewMemDB()
+	dbStore := New(db, "Test_Prune")
+
+	// Empty store
+	size, err := dbStore.Size()
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, size)
+	err = dbStore.Prune(1)
+	require.NoError(t, err)
+
+	// 5 keys (the one at height 3 is saved twice)
+	for _, height := range []int64{1, 2, 3, 3, 4, 5} {
+		err = dbStore.SaveSignedHeaderAndNextValidatorSet(
+			&types.SignedHeader{Header: &types.Header{Height: height}}, &types.ValidatorSet{})
+		require.NoError(t, err)
+	}
+	size, err = dbStore.Size()
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, size)
+
+	// Deleting a missing key does not change the size
+	err = dbStore.DeleteSignedHeaderAndNextValidatorSet(10)
+	require.NoError(t, err)
+	size, err = dbStore.Size()
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, size)
+
+	// The oldest keys are removed
+	err = dbStore.Prune(3)
+	require.NoError(t, err)
+	size, err = dbStore.Size()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, size)
+
+	height, err := dbStore.FirstSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, height)
+	valSet, err := dbStore.ValidatorSet(3)
+	require.Error(t, err)
+	assert.Nil(t, valSet)
+
+	// The size is persisted
+	size, err = New(db, "Test_Prune").Size()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, size)
+}
+
+func Test_PrunePinned(t *testing.T) {
+	dbStore := New(dbm.NewMemDB(), "Test_PrunePinned", 1, 3, 5)
+
+	for height := int64(1); height <= 5; height++ {
+		err := dbStore.SaveSignedHeaderAndNextValidatorSet(
+			&types.SignedHeader{Header: &types.Header{Height: height}}, &types.ValidatorSet{})
+		require.NoError(t, err)
+	}
+
+	// The pinned keys count towards the size, but are kept
+	err := dbStore.Prune(4)
+	require.NoError(t, err)
+	size, err := dbStore.Size()
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, size)
+	_, err = dbStore.SignedHeader(2)
+	assert.Error(t, err)
+
+	// The store holds more keys than the size if too many are pinned
+	err = dbStore.Prune(1)
+	require.NoError(t, err)
+	for _, height := range []int64{1, 3, 5} {
+		_, err = dbStore.SignedHeader(height)
+		assert.NoError(t, err, height)
+	}
+	_, err = dbStore.SignedHeader(4)
+	assert.Error(t, err)
+}
+
+func Test_SizeOfExistingStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	dbStore := New(db, "Test_SizeOfExistingStore")
+	for height := int64(1); height <= 3; height++ {
+		err := dbStore.SaveSignedHeaderAndNextValidatorSet(
+			&types.SignedHeader{Header: &types.Header{Height: height}}, &types.ValidatorSet{})
+		require.NoError(t, err)
+	}
+
+	// Stores created before the size was saved
+	db.Delete(dbStore.(*dbs).sizeKey())
+	size, err := New(db, "Test_SizeOfExistingStore").Size()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, size)
+}
@@ -0,0 +1,224 @@
+package lru
+
+import (
+	"container/list"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/tendermint/tendermint/lite2/store"
+	"github.com/tendermint/tendermint/types"
+)
+
+type lru struct {
+	mtx     sync.Mutex
+	maxSize int
+	pinned  map[int64]struct{}
+
+	// entries by SignedHeader height
+	entries map[int64]*list.Element
+	// most recently used entries first
+	ll *list.List
+	// heights of the entries in ascending order, for the first & last ones
+	heights []int64
+}
+
+type entry struct {
+	sh       *types.SignedHeader
+	nextVals *types.ValidatorSet
+}
+
+// New returns an in-memory Store, which holds at most maxSize SignedHeader &
+// ValidatorSet pairs. When the limit is reached, the least recently used
+// (saved or loaded) pair is evicted. SignedHeaders at the pinned heights (e.g.
+// checkpoints) and the last SignedHeader are never evicted, so the store can
+// grow bigger than maxSize if too many of them are pinned.
+//
+// maxSize must be > 0.
+func New(maxSize int, pinned ...int64) store.Store {
+	if maxSize <= 0 {
+		panic("negative or zero maxSize")
+	}
+
+	s := &lru{
+		maxSize: maxSize,
+		pinned:  make(map[int64]struct{}, len(pinned)),
+		entries: make(map[int64]*list.Element),
+		ll:      list.New(),
+	}
+	for _, height := range pinned {
+		s.pinned[height] = struct{}{}
+	}
+	return s
+}
+
+// SaveSignedHeaderAndNextValidatorSet saves SignedHeader and ValidatorSet and
+// evicts the least recently used pairs if the store is full.
+func (s *lru) SaveSignedHeaderAndNextValidatorSet(sh *types.SignedHeader, valSet *types.ValidatorSet) error {
+	if sh.Height <= 0 {
+		panic("negative or zero height")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	e := &entry{sh: sh, nextVals: valSet}
+	if el, ok := s.entries[sh.Height]; ok {
+		el.Value = e
+		s.ll.MoveToFront(el)
+	} else {
+		s.entries[sh.Height] = s.ll.PushFront(e)
+		s.insertHeight(sh.Height)
+	}
+
+	s.evict(s.maxSize)
+
+	return nil
+}
+
+// DeleteSignedHeaderAndNextValidatorSet deletes SignedHeader and ValidatorSet
+// (even if pinned).
+func (s *lru) DeleteSignedHeaderAndNextValidatorSet(height int64) error {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if el, ok := s.entries[height]; ok {
+		s.ll.Remove(el)
+		delete(s.entries, height)
+		s.removeHeight(height)
+	}
+
+	return nil
+}
+
+// SignedHeader returns SignedHeader at the given height.
+func (s *lru) SignedHeader(height int64) (*types.SignedHeader, error) {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	e := s.get(height)
+	if e == nil {
+		return nil, errors.New("signed header not found")
+	}
+	return e.sh, nil
+}
+
+// ValidatorSet returns ValidatorSet at the given height.
+func (s *lru) ValidatorSet(height int64) (*types.ValidatorSet, error) {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// ValidatorSet (h: height) is saved along with SignedHeader (h: height-1).
+	e := s.get(height - 1)
+	if e == nil {
+		return nil, errors.New("validator set not found")
+	}
+	return e.nextVals, nil
+}
+
+// LastSignedHeaderHeight returns the last SignedHeader height stored.
+func (s *lru) LastSignedHeaderHeight() (int64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.lastHeight(), nil
+}
+
+// FirstSignedHeaderHeight returns the first SignedHeader height stored.
+func (s *lru) FirstSignedHeaderHeight() (int64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.heights) == 0 {
+		return -1, nil
+	}
+	return s.heights[0], nil
+}
+
+// Size returns the number of SignedHeader & ValidatorSet pairs stored.
+func (s *lru) Size() (int, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.ll.Len(), nil
+}
+
+// Prune evicts the least recently used SignedHeader & ValidatorSet pairs
+// until at most size of them are left.
+func (s *lru) Prune(size int) error {
+	if size <= 0 {
+		panic("negative or zero size")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.evict(size)
+
+	return nil
+}
+
+// get returns the entry at the given height (if any) and marks it as the most
+// recently used one. Must be called with mtx held.
+func (s *lru) get(height int64) *entry {
+	el, ok := s.entries[height]
+	if !ok {
+		return nil
+	}
+	s.ll.MoveToFront(el)
+	return el.Value.(*entry)
+}
+
+// evict removes the least recently used entries, except the pinned ones and
+// the last one, until at most size of them are left. Must be called with mtx
+// held.
+func (s *lru) evict(size int) {
+	last := s.lastHeight()
+	for el := s.ll.Back(); el != nil && s.ll.Len() > size; {
+		prev := el.Prev()
+		height := el.Value.(*entry).sh.Height
+		if _, ok := s.pinned[height]; !ok && height != last {
+			s.ll.Remove(el)
+			delete(s.entries, height)
+			s.removeHeight(height)
+		}
+		el = prev
+	}
+}
+
+// lastHeight returns the last SignedHeader height or -1 if the store is
+// empty. Must be called with mtx held.
+func (s *lru) lastHeight() int64 {
+	if len(s.heights) == 0 {
+		return -1
+	}
+	return s.heights[len(s.heights)-1]
+}
+
+// insertHeight adds the height of a new entry to the ordered heights. Must be
+// called with mtx held.
+func (s *lru) insertHeight(height int64) {
+	i := sort.Search(len(s.heights), func(i int) bool { return s.heights[i] >= height })
+	s.heights = append(s.heights, 0)
+	copy(s.heights[i+1:], s.heights[i:])
+	s.heights[i] = height
+}
+
+// removeHeight removes the height of a removed entry from the ordered heights.
+// Must be called with mtx held.
+func (s *lru) removeHeight(height int64) {
+	i := sort.Search(len(s.heights), func(i int) bool { return s.heights[i] >= height })
+	if i < len(s.heights) && s.heights[i] == height {
+		s.heights = append(s.heights[:i], s.heights[i+1:]...)
+	}
+}
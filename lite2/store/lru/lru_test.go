@@ -0,0 +1,190 @@
+package lru
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/lite2/store"
+	"github.com/tendermint/tendermint/types"
+)
+
+func save(t *testing.T, s store.Store, heights ...int64) {
+	for _, height := range heights {
+		err := s.SaveSignedHeaderAndNextValidatorSet(
+			&types.SignedHeader{Header: &types.Header{Height: height}}, &types.ValidatorSet{})
+		require.NoError(t, err)
+	}
+}
+
+func TestLast_FirstSignedHeaderHeight(t *testing.T) {
+	s := New(10)
+
+	// Empty store
+	height, err := s.LastSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, -1, height)
+
+	height, err = s.FirstSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, -1, height)
+
+	// 2 keys
+	save(t, s, 2, 1)
+
+	height, err = s.LastSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, height)
+
+	height, err = s.FirstSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, height)
+
+	// the first & last keys are deleted
+	save(t, s, 3)
+	require.NoError(t, s.DeleteSignedHeaderAndNextValidatorSet(1))
+	require.NoError(t, s.DeleteSignedHeaderAndNextValidatorSet(3))
+
+	height, err = s.LastSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, height)
+
+	height, err = s.FirstSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, height)
+}
+
+func Test_SaveSignedHeaderAndNextValidatorSet(t *testing.T) {
+	s := New(10)
+
+	// Empty store
+	h, err := s.SignedHeader(1)
+	require.Error(t, err)
+	assert.Nil(t, h)
+
+	valSet, err := s.ValidatorSet(2)
+	require.Error(t, err)
+	assert.Nil(t, valSet)
+
+	// 1 key
+	save(t, s, 1)
+
+	h, err = s.SignedHeader(1)
+	require.NoError(t, err)
+	assert.NotNil(t, h)
+
+	valSet, err = s.ValidatorSet(2)
+	require.NoError(t, err)
+	assert.NotNil(t, valSet)
+
+	size, err := s.Size()
+	require.NoError(t, err)
+	assert.Equal(t, 1, size)
+
+	// Empty store
+	err = s.DeleteSignedHeaderAndNextValidatorSet(1)
+	require.NoError(t, err)
+
+	h, err = s.SignedHeader(1)
+	require.Error(t, err)
+	assert.Nil(t, h)
+
+	valSet, err = s.ValidatorSet(2)
+	require.Error(t, err)
+	assert.Nil(t, valSet)
+}
+
+func TestEviction(t *testing.T) {
+	testCases := map[string]struct {
+		pinned     []int64
+		saved      []int64
+		used       []int64
+		savedAfter []int64
+		pruneSize  int
+		left       []int64
+	}{
+		"least recently saved": {
+			saved: []int64{1, 2, 3, 4, 5},
+			left:  []int64{3, 4, 5},
+		},
+		"least recently used": {
+			saved:      []int64{1, 2, 3},
+			used:       []int64{1},
+			savedAfter: []int64{4, 5},
+			left:       []int64{1, 4, 5},
+		},
+		"pinned": {
+			pinned: []int64{1},
+			saved:  []int64{1, 2, 3, 4, 5},
+			left:   []int64{1, 4, 5},
+		},
+		"all pinned but the last": {
+			pinned: []int64{1, 2, 3, 4},
+			saved:  []int64{1, 2, 3, 4, 5},
+			left:   []int64{1, 2, 3, 4, 5},
+		},
+		"last is never evicted": {
+			saved: []int64{5, 1, 2, 3, 4},
+			left:  []int64{3, 4, 5},
+		},
+		"prune": {
+			saved:     []int64{1, 2, 3, 4, 5},
+			pruneSize: 1,
+			left:      []int64{5},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			s := New(3, tc.pinned...)
+			save(t, s, tc.saved...)
+			for _, height := range tc.used {
+				_, err := s.SignedHeader(height)
+				require.NoError(t, err)
+			}
+			save(t, s, tc.savedAfter...)
+			if tc.pruneSize > 0 {
+				require.NoError(t, s.Prune(tc.pruneSize))
+			}
+
+			size, err := s.Size()
+			require.NoError(t, err)
+			assert.Equal(t, len(tc.left), size)
+			for _, height := range tc.left {
+				_, err := s.SignedHeader(height)
+				assert.NoError(t, err, "height %d", height)
+			}
+		})
+	}
+}
+
+func TestConcurrency(t *testing.T) {
+	s := New(5)
+
+	var wg sync.WaitGroup
+	for i := int64(1); i <= 100; i++ {
+		wg.Add(1)
+		go func(height int64) {
+			defer wg.Done()
+
+			save(t, s, height)
+			_, _ = s.SignedHeader(height)
+			_, _ = s.ValidatorSet(height + 1)
+			_, err := s.LastSignedHeaderHeight()
+			assert.NoError(t, err)
+			_, err = s.FirstSignedHeaderHeight()
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	size, err := s.Size()
+	require.NoError(t, err)
+	assert.Equal(t, 5, size)
+	height, err := s.LastSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, height)
+}
@@ -0,0 +1,233 @@
+package sql
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/go-amino"
+
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/libs/sqldialect"
+	"github.com/tendermint/tendermint/lite2/store"
+	"github.com/tendermint/tendermint/types"
+)
+
+type sqls struct {
+	db      *sql.DB
+	dialect sqldialect.Dialect
+	prefix  string
+	pinned  []int64
+
+	cdc *amino.Codec
+}
+
+// New returns a Store backed by an open SQL database of the given driver
+// ("sqlite3" or "postgres"), and creates the lite_headers table if it doesn't
+// exist yet. The driver must be registered by the caller. Like in store/db,
+// the prefix allows many light clients to use the same database.
+//
+// Every operation is a single SQL statement, so the store can be shared by
+// multiple processes. When using SQLite, set a busy timeout and enable WAL
+// mode (e.g. "file.db?_busy_timeout=5000&_journal_mode=WAL") to avoid
+// "database is locked" errors.
+//
+// Prune removes the oldest headers, except the ones at the pinned heights
+// (e.g. the trust root and checkpoints), so the store can hold more headers
+// than the size given to Prune if too many of them are pinned.
+func New(db *sql.DB, driverName, prefix string, pinned ...int64) (store.Store, error) {
+	d, err := sqldialect.ForDriver(driverName)
+	if err != nil {
+		return nil, err
+	}
+
+	cdc := amino.NewCodec()
+	cryptoAmino.RegisterAmino(cdc)
+
+	s := &sqls{db: db, dialect: d, prefix: prefix, pinned: pinned, cdc: cdc}
+	if err := s.createTable(); err != nil {
+		return nil, errors.Wrap(err, "failed to create table")
+	}
+	return s, nil
+}
+
+func (s *sqls) createTable() error {
+	_, err := s.db.Exec(fmt.Sprintf(`CREATE TABLE IF NOT EXISTS lite_headers (
+		prefix VARCHAR(255) NOT NULL,
+		height BIGINT NOT NULL,
+		signed_header %[1]s NOT NULL,
+		next_validator_set %[1]s NOT NULL,
+		PRIMARY KEY (prefix, height)
+	)`, s.dialect.BlobType))
+	return err
+}
+
+// SaveSignedHeaderAndNextValidatorSet persists SignedHeader and ValidatorSet
+// to the database, replacing the existing ones.
+func (s *sqls) SaveSignedHeaderAndNextValidatorSet(sh *types.SignedHeader, valSet *types.ValidatorSet) error {
+	if sh.Height <= 0 {
+		panic("negative or zero height")
+	}
+
+	shBz, err := s.cdc.MarshalBinaryLengthPrefixed(sh)
+	if err != nil {
+		return err
+	}
+
+	vsBz, err := s.cdc.MarshalBinaryLengthPrefixed(valSet)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(s.dialect.Rebind(`INSERT INTO lite_headers (prefix, height, signed_header, next_validator_set)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (prefix, height) DO UPDATE
+		SET signed_header = excluded.signed_header, next_validator_set = excluded.next_validator_set`),
+		s.prefix, sh.Height, shBz, vsBz)
+	return err
+}
+
+// DeleteSignedHeaderAndNextValidatorSet deletes SignedHeader and ValidatorSet
+// from the database.
+func (s *sqls) DeleteSignedHeaderAndNextValidatorSet(height int64) error {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	_, err := s.db.Exec(s.dialect.Rebind(`DELETE FROM lite_headers WHERE prefix = ? AND height = ?`),
+		s.prefix, height)
+	return err
+}
+
+// SignedHeader loads SignedHeader at the given height.
+func (s *sqls) SignedHeader(height int64) (*types.SignedHeader, error) {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	var bz []byte
+	err := s.db.QueryRow(s.dialect.Rebind(`SELECT signed_header FROM lite_headers WHERE prefix = ? AND height = ?`),
+		s.prefix, height).Scan(&bz)
+	if err == sql.ErrNoRows {
+		return nil, errors.New("signed header not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	var signedHeader *types.SignedHeader
+	err = s.cdc.UnmarshalBinaryLengthPrefixed(bz, &signedHeader)
+	return signedHeader, err
+}
+
+// ValidatorSet loads ValidatorSet at the given height.
+func (s *sqls) ValidatorSet(height int64) (*types.ValidatorSet, error) {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	// ValidatorSet (h: height) is saved along with SignedHeader (h: height-1).
+	var bz []byte
+	err := s.db.QueryRow(s.dialect.Rebind(`SELECT next_validator_set FROM lite_headers WHERE prefix = ? AND height = ?`),
+		s.prefix, height-1).Scan(&bz)
+	if err == sql.ErrNoRows {
+		return nil, errors.New("validator set not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	var valSet *types.ValidatorSet
+	err = s.cdc.UnmarshalBinaryLengthPrefixed(bz, &valSet)
+	return valSet, err
+}
+
+// LastSignedHeaderHeight returns the last SignedHeader height stored.
+func (s *sqls) LastSignedHeaderHeight() (int64, error) {
+	return s.queryHeight(`SELECT MAX(height) FROM lite_headers WHERE prefix = ?`)
+}
+
+// FirstSignedHeaderHeight returns the first SignedHeader height stored.
+func (s *sqls) FirstSignedHeaderHeight() (int64, error) {
+	return s.queryHeight(`SELECT MIN(height) FROM lite_headers WHERE prefix = ?`)
+}
+
+// Size returns the number of SignedHeader & ValidatorSet pairs stored.
+func (s *sqls) Size() (int, error) {
+	var size int
+	err := s.db.QueryRow(s.dialect.Rebind(`SELECT COUNT(*) FROM lite_headers WHERE prefix = ?`),
+		s.prefix).Scan(&size)
+	return size, err
+}
+
+// Prune removes the oldest SignedHeader & ValidatorSet pairs, which are not
+// pinned, until at most size of them are left. The pinned pairs are counted
+// and removed in a single transaction.
+func (s *sqls) Prune(size int) error {
+	if size <= 0 {
+		panic("negative or zero size")
+	}
+
+	var (
+		pinnedList string
+		pinnedArgs = make([]interface{}, len(s.pinned))
+		notPinned  string
+	)
+	for i, height := range s.pinned {
+		pinnedArgs[i] = height
+	}
+	if len(s.pinned) > 0 {
+		pinnedList = "(?" + strings.Repeat(", ?", len(s.pinned)-1) + ")"
+		notPinned = "AND height NOT IN " + pinnedList
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // nolint: errcheck
+
+	// the pinned pairs count towards the size
+	keep := size
+	if len(s.pinned) > 0 {
+		var pinnedSize int
+		err := tx.QueryRow(s.dialect.Rebind(`SELECT COUNT(*) FROM lite_headers WHERE prefix = ? AND height IN `+pinnedList),
+			append([]interface{}{s.prefix}, pinnedArgs...)...).Scan(&pinnedSize)
+		if err != nil {
+			return err
+		}
+		keep -= pinnedSize
+		if keep < 0 {
+			keep = 0
+		}
+	}
+
+	// the last pair is never removed
+	args := []interface{}{s.prefix}
+	args = append(args, pinnedArgs...)
+	args = append(args, s.prefix, s.prefix)
+	args = append(args, pinnedArgs...)
+	args = append(args, keep)
+	_, err = tx.Exec(s.dialect.Rebind(fmt.Sprintf(`DELETE FROM lite_headers WHERE prefix = ? %[1]s
+		AND height < (SELECT MAX(height) FROM lite_headers WHERE prefix = ?)
+		AND height NOT IN (
+			SELECT height FROM lite_headers WHERE prefix = ? %[1]s ORDER BY height DESC LIMIT ?
+		)`, notPinned)),
+		args...)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// queryHeight runs the query, which selects a single (possibly NULL) height,
+// and returns -1 if it is NULL.
+func (s *sqls) queryHeight(query string) (int64, error) {
+	var height sql.NullInt64
+	if err := s.db.QueryRow(s.dialect.Rebind(query), s.prefix).Scan(&height); err != nil {
+		return -1, err
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
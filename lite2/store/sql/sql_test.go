@@ -0,0 +1,227 @@
+package sql
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/lite2/store"
+	"github.com/tendermint/tendermint/types"
+)
+
+// newTestDB opens a temporary SQLite database. Each call with the same dir
+// opens a separate connection pool, like another process would.
+func newTestDB(t *testing.T, dir string) *sql.DB {
+	db, err := sql.Open("sqlite3", filepath.Join(dir, "lite.db")+"?_busy_timeout=5000&_journal_mode=WAL")
+	require.NoError(t, err)
+	return db
+}
+
+func newTestStore(t *testing.T, prefix string) (store.Store, func()) {
+	dir, err := ioutil.TempDir("", "lite_sql_store")
+	require.NoError(t, err)
+
+	db := newTestDB(t, dir)
+	s, err := New(db, "sqlite3", prefix)
+	require.NoError(t, err)
+
+	return s, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func save(t *testing.T, s store.Store, heights ...int64) {
+	for _, height := range heights {
+		err := s.SaveSignedHeaderAndNextValidatorSet(
+			&types.SignedHeader{Header: &types.Header{Height: height}}, &types.ValidatorSet{})
+		require.NoError(t, err)
+	}
+}
+
+func TestNew_unsupportedDriver(t *testing.T) {
+	_, err := New(nil, "mysql", "")
+	assert.Error(t, err)
+}
+
+func TestLast_FirstSignedHeaderHeight(t *testing.T) {
+	s, cleanup := newTestStore(t, "TestLast_FirstSignedHeaderHeight")
+	defer cleanup()
+
+	// Empty store
+	height, err := s.LastSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, -1, height)
+
+	height, err = s.FirstSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, -1, height)
+
+	// 2 keys
+	save(t, s, 1, 2)
+
+	height, err = s.LastSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, height)
+
+	height, err = s.FirstSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, height)
+}
+
+func Test_SaveSignedHeaderAndNextValidatorSet(t *testing.T) {
+	s, cleanup := newTestStore(t, "Test_SaveSignedHeaderAndNextValidatorSet")
+	defer cleanup()
+
+	// Empty store
+	h, err := s.SignedHeader(1)
+	require.Error(t, err)
+	assert.Nil(t, h)
+
+	valSet, err := s.ValidatorSet(2)
+	require.Error(t, err)
+	assert.Nil(t, valSet)
+
+	// 1 key (saved twice)
+	save(t, s, 1, 1)
+
+	h, err = s.SignedHeader(1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, h.Height)
+
+	valSet, err = s.ValidatorSet(2)
+	require.NoError(t, err)
+	assert.NotNil(t, valSet)
+
+	size, err := s.Size()
+	require.NoError(t, err)
+	assert.Equal(t, 1, size)
+
+	// Empty store
+	err = s.DeleteSignedHeaderAndNextValidatorSet(1)
+	require.NoError(t, err)
+
+	h, err = s.SignedHeader(1)
+	require.Error(t, err)
+	assert.Nil(t, h)
+
+	valSet, err = s.ValidatorSet(2)
+	require.Error(t, err)
+	assert.Nil(t, valSet)
+}
+
+func Test_Prune(t *testing.T) {
+	s, cleanup := newTestStore(t, "Test_Prune")
+	defer cleanup()
+
+	save(t, s, 1, 2, 3, 4, 5)
+
+	err := s.Prune(3)
+	require.NoError(t, err)
+
+	size, err := s.Size()
+	require.NoError(t, err)
+	assert.Equal(t, 3, size)
+
+	height, err := s.FirstSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, height)
+
+	// Nothing to prune
+	err = s.Prune(10)
+	require.NoError(t, err)
+	size, err = s.Size()
+	require.NoError(t, err)
+	assert.Equal(t, 3, size)
+}
+
+func Test_PrunePinned(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lite_sql_store")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db := newTestDB(t, dir)
+	defer db.Close()
+
+	s, err := New(db, "sqlite3", "Test_PrunePinned", 1, 3)
+	require.NoError(t, err)
+	save(t, s, 1, 2, 3, 4, 5, 6)
+
+	// the pinned headers count towards the size, but are kept
+	err = s.Prune(4)
+	require.NoError(t, err)
+	size, err := s.Size()
+	require.NoError(t, err)
+	assert.Equal(t, 4, size)
+	for _, height := range []int64{1, 3, 5, 6} {
+		_, err = s.SignedHeader(height)
+		assert.NoError(t, err, height)
+	}
+
+	// the store holds more headers than the size if too many are pinned, but
+	// the last one is never removed
+	err = s.Prune(1)
+	require.NoError(t, err)
+	size, err = s.Size()
+	require.NoError(t, err)
+	assert.Equal(t, 3, size)
+	height, err := s.LastSignedHeaderHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 6, height)
+}
+
+func TestSharedDatabase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lite_sql_store")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Two "processes" with their own connections share the database.
+	db1, db2 := newTestDB(t, dir), newTestDB(t, dir)
+	defer db1.Close()
+	defer db2.Close()
+
+	s1, err := New(db1, "sqlite3", "chain")
+	require.NoError(t, err)
+	s2, err := New(db2, "sqlite3", "chain")
+	require.NoError(t, err)
+	other, err := New(db2, "sqlite3", "other-chain")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := int64(1); i <= 20; i++ {
+		wg.Add(1)
+		go func(height int64) {
+			defer wg.Done()
+			s := s1
+			if height%2 == 0 {
+				s = s2
+			}
+			err := s.SaveSignedHeaderAndNextValidatorSet(
+				&types.SignedHeader{Header: &types.Header{Height: height}}, &types.ValidatorSet{})
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	// Headers saved by one process are visible to the other.
+	for _, s := range []store.Store{s1, s2} {
+		size, err := s.Size()
+		require.NoError(t, err)
+		assert.Equal(t, 20, size)
+
+		height, err := s.LastSignedHeaderHeight()
+		require.NoError(t, err)
+		assert.EqualValues(t, 20, height)
+	}
+
+	// Other prefixes are not affected.
+	size, err := other.Size()
+	require.NoError(t, err)
+	assert.Equal(t, 0, size)
+}
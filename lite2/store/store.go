@@ -40,4 +40,14 @@ type Store interface {
 	//
 	// If the store is empty, -1 and nil error are returned.
 	FirstSignedHeaderHeight() (int64, error)
+
+	// Size returns the number of SignedHeader & ValidatorSet pairs stored.
+	Size() (int, error)
+
+	// Prune removes SignedHeader & ValidatorSet pairs, according to the store's
+	// eviction policy, until at most size of them are left. The last
+	// SignedHeader is never removed.
+	//
+	// size must be > 0.
+	Prune(size int) error
 }
@@ -147,3 +147,17 @@ func (pkz privKeys) GenSignedHeader(chainID string, height int64, bTime time.Tim
 		Commit: pkz.signHeader(header, first, last),
 	}
 }
+
+// GenSignedHeaderLastBlockID calls genHeader and signHeader and combines them
+// into a SignedHeader, which links to the previous block (lastBlockID).
+func (pkz privKeys) GenSignedHeaderLastBlockID(chainID string, height int64, bTime time.Time, txs types.Txs,
+	valset, nextValset *types.ValidatorSet, appHash, consHash, resHash []byte, first, last int,
+	lastBlockID types.BlockID) *types.SignedHeader {
+
+	header := genHeader(chainID, height, bTime, txs, valset, nextValset, appHash, consHash, resHash)
+	header.LastBlockID = lastBlockID
+	return &types.SignedHeader{
+		Header: header,
+		Commit: pkz.signHeader(header, first, last),
+	}
+}
@@ -78,6 +78,41 @@ func Verify(
 	return nil
 }
 
+// VerifyBackwards verifies an untrusted header with a height one less than
+// that of an adjacent trusted header. It ensures that:
+//
+//	a) untrusted header is valid;
+//	b) untrusted header has a time before the trusted header;
+//	c) untrusted header's hash matches the trusted header's LastBlockID.
+//
+// Commit signatures are not checked: the hash link from the trusted header is
+// enough to trust the untrusted one.
+func VerifyBackwards(chainID string, untrustedHeader, trustedHeader *types.SignedHeader) error {
+	if err := untrustedHeader.ValidateBasic(chainID); err != nil {
+		return errors.Wrap(err, "untrustedHeader.ValidateBasic failed")
+	}
+
+	if untrustedHeader.Height != trustedHeader.Height-1 {
+		return errors.Errorf("expected untrusted header height %d to be one less than one of trusted header %d",
+			untrustedHeader.Height,
+			trustedHeader.Height)
+	}
+
+	if !untrustedHeader.Time.Before(trustedHeader.Time) {
+		return errors.Errorf("expected untrusted header time %v to be before trusted header time %v",
+			untrustedHeader.Time,
+			trustedHeader.Time)
+	}
+
+	if !bytes.Equal(untrustedHeader.Hash(), trustedHeader.LastBlockID.Hash) {
+		return errors.Errorf("expected untrusted header hash %X to match trusted header's last block %X",
+			untrustedHeader.Hash(),
+			trustedHeader.LastBlockID.Hash)
+	}
+
+	return nil
+}
+
 func verifyNewHeaderAndVals(
 	chainID string,
 	h2 *types.SignedHeader,
@@ -288,6 +288,51 @@ func TestVerifyReturnsErrorIfTrustLevelIsInvalid(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestVerifyBackwards(t *testing.T) {
+	const (
+		chainID = "TestVerifyBackwards"
+	)
+
+	var (
+		keys     = genPrivKeys(4)
+		vals     = keys.ToValidators(20, 10)
+		bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		header   = keys.GenSignedHeader(chainID, 1, bTime, nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys))
+		trustedHeader = keys.GenSignedHeaderLastBlockID(chainID, 2, bTime.Add(1*time.Hour), nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys),
+			types.BlockID{Hash: header.Hash()})
+	)
+
+	testCases := []struct {
+		untrustedHeader *types.SignedHeader
+		expErr          bool
+	}{
+		// good
+		0: {header, false},
+		// different chainID -> error
+		1: {keys.GenSignedHeader("different-chainID", 1, bTime, nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys)), true},
+		// not adjacent -> error
+		2: {trustedHeader, true},
+		// time after trusted header's time -> error
+		3: {keys.GenSignedHeader(chainID, 1, bTime.Add(2*time.Hour), nil, vals, vals,
+			[]byte("app_hash"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys)), true},
+		// hash does not match trusted header's LastBlockID -> error
+		4: {keys.GenSignedHeader(chainID, 1, bTime, nil, vals, vals,
+			[]byte("app_hash2"), []byte("cons_hash"), []byte("results_hash"), 0, len(keys)), true},
+	}
+
+	for i, tc := range testCases {
+		err := VerifyBackwards(chainID, tc.untrustedHeader, trustedHeader)
+		if tc.expErr {
+			assert.Error(t, err, "#%d", i)
+		} else {
+			assert.NoError(t, err, "#%d", i)
+		}
+	}
+}
+
 func TestValidateTrustLevel(t *testing.T) {
 	testCases := []struct {
 		lvl   tmmath.Fraction
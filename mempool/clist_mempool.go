@@ -9,15 +9,11 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/pkg/errors"
-
 	abci "github.com/tendermint/tendermint/abci/types"
 	cfg "github.com/tendermint/tendermint/config"
-	auto "github.com/tendermint/tendermint/libs/autofile"
 	"github.com/tendermint/tendermint/libs/clist"
 	"github.com/tendermint/tendermint/libs/log"
 	tmmath "github.com/tendermint/tendermint/libs/math"
-	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/types"
@@ -62,12 +58,32 @@ type CListMempool struct {
 	// This reduces the pressure on the proxyApp.
 	cache txCache
 
-	// A log of mempool txs
-	wal *auto.AutoFile
+	// Count the txs per peer and per sender
+	quotas *txQuotas
+
+	// A log of mempool txs, replayed on start
+	wal *txWAL
+	// Filter of the committed txs of the WAL
+	txCommitted TxCommittedFunc
 
 	logger log.Logger
 
 	metrics *Metrics
+
+	eventBus types.MempoolEventPublisher
+
+	// Ordering of the PriorityMempool, nil for the txs reaped in the order
+	// they were checked:
+	// makeRoom returns the txs to evict to make room for memTx if the mempool
+	// is full, so that a full mempool does not reject the txs before CheckTx
+	makeRoom func(memTx *mempoolTx) ([]*clist.CElement, error)
+	// reapOrder returns the txs in the order they are reaped
+	reapOrder func() []*mempoolTx
+	// txAdded, txRemoved and txUpdated are called when a tx is added to the
+	// list, removed from it, or has its priority changed by a recheck
+	txAdded   func(e *clist.CElement)
+	txRemoved func(e *clist.CElement)
+	txUpdated func(e *clist.CElement)
 }
 
 var _ Mempool = &CListMempool{}
@@ -90,8 +106,10 @@ func NewCListMempool(
 		rechecking:    0,
 		recheckCursor: nil,
 		recheckEnd:    nil,
+		quotas:        newTxQuotas(config),
 		logger:        log.NewNopLogger(),
 		metrics:       NopMetrics(),
+		eventBus:      types.NopEventBus{},
 	}
 	if config.CacheSize > 0 {
 		mempool.cache = newMapTxCache(config.CacheSize)
@@ -132,31 +150,56 @@ func WithMetrics(metrics *Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
 }
 
+// WithEventBus sets the event bus the evicted txs are published on.
+func WithEventBus(eventBus types.MempoolEventPublisher) CListMempoolOption {
+	return func(mem *CListMempool) { mem.eventBus = eventBus }
+}
+
+// WithTxCommitted sets the function used to drop the committed txs of the
+// WAL when it is replayed.
+func WithTxCommitted(f TxCommittedFunc) CListMempoolOption {
+	return func(mem *CListMempool) { mem.txCommitted = f }
+}
+
 // *panics* if can't create directory or open file.
 // *not thread safe*
+//
+// The txs pending in the WAL, e.g. before a crash or an upgrade, are checked
+// again against the application, except the committed ones, and the WAL is
+// compacted to the ones added back to the mempool.
 func (mem *CListMempool) InitWAL() {
-	walDir := mem.config.WalDir()
-	err := tmos.EnsureDir(walDir, 0700)
-	if err != nil {
-		panic(errors.Wrap(err, "Error ensuring WAL dir"))
-	}
-	af, err := auto.OpenAutoFile(walDir + "/wal")
+	wal, txs, err := openTxWAL(mem.config.WalDir(), mem.logger)
 	if err != nil {
-		panic(errors.Wrap(err, "Error opening WAL file"))
+		panic(err)
 	}
-	mem.wal = af
+	mem.wal = wal
+	replayWAL(mem, txs, mem.txCommitted, mem.logger)
+
+	mem.proxyMtx.Lock()
+	defer mem.proxyMtx.Unlock()
+	mem.compactWAL()
 }
 
+// CloseWAL compacts and closes the WAL.
 func (mem *CListMempool) CloseWAL() {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
 
+	mem.compactWAL()
 	if err := mem.wal.Close(); err != nil {
 		mem.logger.Error("Error closing WAL", "err", err)
 	}
 	mem.wal = nil
 }
 
+// compactWAL replaces the records of the WAL with the txs in the mempool.
+// NOTE: the lock must be held
+func (mem *CListMempool) compactWAL() {
+	if err := mem.wal.Compact(clistTxs(mem.txs)); err != nil {
+		mem.logger.Error("Error compacting WAL", "err", err)
+	}
+}
+
 func (mem *CListMempool) Lock() {
 	mem.proxyMtx.Lock()
 }
@@ -186,9 +229,13 @@ func (mem *CListMempool) Flush() {
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
 		mem.txs.Remove(e)
 		e.DetachPrev()
+		if mem.txRemoved != nil {
+			mem.txRemoved(e)
+		}
 	}
 
 	mem.txsMap = sync.Map{}
+	mem.quotas.reset()
 	_ = atomic.SwapInt64(&mem.txsBytes, 0)
 }
 
@@ -219,9 +266,13 @@ func (mem *CListMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo Tx
 		memSize  = mem.Size()
 		txsBytes = mem.TxsBytes()
 		txSize   = len(tx)
+		isFull   = memSize >= mem.config.Size || int64(txSize)+txsBytes > mem.config.MaxTxsBytes
 	)
-	if memSize >= mem.config.Size ||
-		int64(txSize)+txsBytes > mem.config.MaxTxsBytes {
+	if mem.makeRoom != nil {
+		// txs may be evicted to make room for tx, unless it can never fit
+		isFull = mem.config.Size == 0 || int64(txSize) > mem.config.MaxTxsBytes
+	}
+	if isFull {
 		return ErrMempoolIsFull{
 			memSize, mem.config.Size,
 			txsBytes, mem.config.MaxTxsBytes}
@@ -240,6 +291,10 @@ func (mem *CListMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo Tx
 		}
 	}
 
+	if err := mem.quotas.checkPeer(txInfo.SenderP2PID); err != nil {
+		return err
+	}
+
 	// CACHE
 	if !mem.cache.Push(tx) {
 		// Record a new sender for a tx we've already seen.
@@ -259,20 +314,6 @@ func (mem *CListMempool) CheckTx(tx types.Tx, cb func(*abci.Response), txInfo Tx
 	}
 	// END CACHE
 
-	// WAL
-	if mem.wal != nil {
-		// TODO: Notify administrators when WAL fails
-		_, err := mem.wal.Write([]byte(tx))
-		if err != nil {
-			mem.logger.Error("Error writing to WAL", "err", err)
-		}
-		_, err = mem.wal.Write([]byte("\n"))
-		if err != nil {
-			mem.logger.Error("Error writing to WAL", "err", err)
-		}
-	}
-	// END WAL
-
 	// NOTE: proxyAppConn may error if tx buffer is full
 	if err = mem.proxyAppConn.Error(); err != nil {
 		return err
@@ -338,27 +379,86 @@ func (mem *CListMempool) reqResCb(
 
 // Called from:
 //  - resCbFirstTime (lock not held) if tx is valid
-func (mem *CListMempool) addTx(memTx *mempoolTx) {
+//
+// Evicts the txs returned by makeRoom, or returns its error if it can't make
+// room for memTx.
+func (mem *CListMempool) addTx(memTx *mempoolTx) error {
+	if mem.makeRoom != nil {
+		victims, err := mem.makeRoom(memTx)
+		if err != nil {
+			return err
+		}
+		for _, e := range victims {
+			mem.evictTx(e, EvictedForPriority)
+		}
+	}
+
 	e := mem.txs.PushBack(memTx)
 	mem.txsMap.Store(txKey(memTx.tx), e)
+	if mem.txAdded != nil {
+		mem.txAdded(e)
+	}
+	mem.quotas.add(memTx)
+	if mem.wal != nil {
+		// TODO: Notify administrators when WAL fails
+		if err := mem.wal.Add(memTx.tx); err != nil {
+			mem.logger.Error("Error writing to WAL", "err", err)
+		}
+	}
 	atomic.AddInt64(&mem.txsBytes, int64(len(memTx.tx)))
 	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
+	return nil
 }
 
 // Called from:
 //  - Update (lock held) if tx was committed
 // 	- resCbRecheck (lock not held) if tx was invalidated
+//  - evictTx (lock held or not) if tx was evicted
 func (mem *CListMempool) removeTx(tx types.Tx, elem *clist.CElement, removeFromCache bool) {
 	mem.txs.Remove(elem)
 	elem.DetachPrev()
 	mem.txsMap.Delete(txKey(tx))
+	if mem.txRemoved != nil {
+		mem.txRemoved(elem)
+	}
+	mem.quotas.remove(elem.Value.(*mempoolTx))
 	atomic.AddInt64(&mem.txsBytes, int64(-len(tx)))
+	if mem.wal != nil {
+		if err := mem.wal.Remove(tx); err != nil {
+			mem.logger.Error("Error writing to WAL", "err", err)
+		}
+	}
 
 	if removeFromCache {
 		mem.cache.Remove(tx)
 	}
 }
 
+// evictTx removes the tx from the mempool and the cache, so that it can be
+// resubmitted, and publishes its eviction.
+func (mem *CListMempool) evictTx(elem *clist.CElement, reason string) {
+	memTx := elem.Value.(*mempoolTx)
+	mem.removeTx(memTx.tx, elem, true)
+	mem.metrics.EvictedTxs.With("reason", reason).Add(1)
+	mem.logger.Info("Evicted transaction", "tx", txID(memTx.tx), "priority", memTx.priority, "reason", reason)
+	if err := mem.eventBus.PublishEventEvictedTx(types.EventDataEvictedTx{Tx: memTx.tx, Reason: reason}); err != nil {
+		mem.logger.Error("Error publishing evicted tx", "err", err)
+	}
+}
+
+// evictExpiredTxs evicts the txs which outlived the ttl_num_blocks or
+// ttl_duration of the config.
+func (mem *CListMempool) evictExpiredTxs(height int64, now time.Time) {
+	if mem.config.TTLNumBlocks == 0 && mem.config.TTLDuration == 0 {
+		return
+	}
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		if isExpired(mem.config, e.Value.(*mempoolTx), height, now) {
+			mem.evictTx(e, EvictedExpired)
+		}
+	}
+}
+
 // callback, which is called after the app checked the tx for the first time.
 //
 // The case where the app checks the tx for the second and subsequent times is
@@ -378,11 +478,28 @@ func (mem *CListMempool) resCbFirstTime(
 		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
 			memTx := &mempoolTx{
 				height:    mem.height,
+				timestamp: time.Now(),
 				gasWanted: r.CheckTx.GasWanted,
 				tx:        tx,
+				priority:  r.CheckTx.Priority,
+				sender:    r.CheckTx.Sender,
+				peerID:    peerID,
+				peerP2PID: peerP2PID,
 			}
 			memTx.senders.Store(peerID, true)
-			mem.addTx(memTx)
+			err := mem.quotas.check(memTx)
+			if err == nil {
+				err = mem.addTx(memTx)
+			}
+			if err != nil {
+				r.CheckTx.MempoolError = err.Error()
+				mem.logger.Info("Rejected good transaction",
+					"tx", txID(tx), "peerID", peerP2PID, "priority", memTx.priority, "err", err)
+				mem.metrics.FailedTxs.Add(1)
+				// remove from cache (it might fit later)
+				mem.cache.Remove(tx)
+				return
+			}
 			mem.logger.Info("Added good transaction",
 				"tx", txID(tx),
 				"res", r,
@@ -403,7 +520,8 @@ func (mem *CListMempool) resCbFirstTime(
 	}
 }
 
-// callback, which is called after the app rechecked the tx.
+// callback, which is called after the app rechecked the tx. The priority of
+// the tx is updated with the one of the response.
 //
 // The case where the app checks the tx for the first time is handled by the
 // resCbFirstTime callback.
@@ -423,7 +541,11 @@ func (mem *CListMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 			postCheckErr = mem.postCheck(tx, r.CheckTx)
 		}
 		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
-			// Good, nothing to do.
+			// NOTE: reaping waits for the end of the recheck
+			memTx.priority = r.CheckTx.Priority
+			if mem.txUpdated != nil {
+				mem.txUpdated(mem.recheckCursor)
+			}
 		} else {
 			// Tx became invalidated due to newly committed block.
 			mem.logger.Info("Tx is no longer valid", "tx", txID(tx), "res", r, "err", postCheckErr)
@@ -468,6 +590,19 @@ func (mem *CListMempool) notifyTxsAvailable() {
 	}
 }
 
+// reapTxs returns the txs in the order they are reaped: by reapOrder, or in
+// the order they were checked.
+func (mem *CListMempool) reapTxs() []*mempoolTx {
+	if mem.reapOrder != nil {
+		return mem.reapOrder()
+	}
+	txs := make([]*mempoolTx, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*mempoolTx))
+	}
+	return txs
+}
+
 func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
@@ -482,9 +617,9 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	// TODO: we will get a performance boost if we have a good estimate of avg
 	// size per tx, and set the initial capacity based off of that.
 	// txs := make([]types.Tx, 0, tmmath.MinInt(mem.txs.Len(), max/mem.avgTxSize))
-	txs := make([]types.Tx, 0, mem.txs.Len())
-	for e := mem.txs.Front(); e != nil; e = e.Next() {
-		memTx := e.Value.(*mempoolTx)
+	memTxs := mem.reapTxs()
+	txs := make([]types.Tx, 0, len(memTxs))
+	for _, memTx := range memTxs {
 		// Check total size requirement
 		aminoOverhead := types.ComputeAminoOverhead(memTx.tx, 1)
 		if maxBytes > -1 && totalBytes+int64(len(memTx.tx))+aminoOverhead > maxBytes {
@@ -509,18 +644,21 @@ func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
 	mem.proxyMtx.Lock()
 	defer mem.proxyMtx.Unlock()
 
-	if max < 0 {
-		max = mem.txs.Len()
-	}
-
 	for atomic.LoadInt32(&mem.rechecking) > 0 {
 		// TODO: Something better?
 		time.Sleep(time.Millisecond * 10)
 	}
 
-	txs := make([]types.Tx, 0, tmmath.MinInt(mem.txs.Len(), max))
-	for e := mem.txs.Front(); e != nil && len(txs) <= max; e = e.Next() {
-		memTx := e.Value.(*mempoolTx)
+	memTxs := mem.reapTxs()
+	if max < 0 {
+		max = len(memTxs)
+	}
+
+	txs := make([]types.Tx, 0, tmmath.MinInt(len(memTxs), max))
+	for _, memTx := range memTxs {
+		if len(txs) >= max {
+			break
+		}
 		txs = append(txs, memTx.tx)
 	}
 	return txs
@@ -568,6 +706,13 @@ func (mem *CListMempool) Update(
 		}
 	}
 
+	// Evict the expired txs, which are not rechecked
+	mem.evictExpiredTxs(height, time.Now())
+
+	if mem.wal != nil && mem.wal.NeedsCompaction(mem.Size()) {
+		mem.compactWAL()
+	}
+
 	// Either recheck non-committed txs to see if they became invalid
 	// or just notify there're some txs left.
 	if mem.Size() > 0 {
@@ -614,9 +759,19 @@ func (mem *CListMempool) recheckTxs() {
 
 // mempoolTx is a transaction that successfully ran
 type mempoolTx struct {
-	height    int64    // height that this tx had been validated in
-	gasWanted int64    // amount of gas this tx states it will require
-	tx        types.Tx //
+	height    int64     // height that this tx had been validated in
+	timestamp time.Time // time that this tx was added to the mempool
+	gasWanted int64     // amount of gas this tx states it will require
+	tx        types.Tx  //
+
+	// priority and sender returned by the app in CheckTx
+	priority int64
+	sender   string
+
+	// id of the peer who sent us this tx first, and its p2p.ID, which unlike
+	// the id is not reused for another peer (empty for the RPC)
+	peerID    uint16
+	peerP2PID p2p.ID
 
 	// ids of peers who've sent us this tx (as a map for quick lookups).
 	// senders: PeerID -> bool
@@ -1,6 +1,8 @@
 package mempool
 
 import (
+	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/binary"
@@ -25,6 +27,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	"github.com/tendermint/tendermint/libs/service"
+	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/types"
 )
@@ -395,11 +398,11 @@ func TestMempoolCloseWAL(t *testing.T) {
 
 	// 5. Write some contents to the WAL
 	mempool.CheckTx(types.Tx([]byte("foo")), nil, TxInfo{})
-	walFilepath := mempool.wal.Path
+	walFilepath := mempool.wal.Path()
 	sum1 := checksumFile(walFilepath, t)
 
 	// 6. Sanity check to ensure that the written TX matches the expectation.
-	require.Equal(t, sum1, checksumIt([]byte("foo\n")), "foo with a newline should be written")
+	require.Equal(t, sum1, checksumIt(walRecord(walRecordAdd, []byte("foo"))), "foo should be written")
 
 	// 7. Invoke CloseWAL() and ensure it discards the
 	// WAL thus any other write won't go through.
@@ -421,6 +424,65 @@ func txMessageSize(tx types.Tx) int {
 	return amino.ByteSliceSize(tx) + 1 + 4
 }
 
+func TestMempoolWALReplay(t *testing.T) {
+	rootDir, err := ioutil.TempDir("", "mempool-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(rootDir)
+
+	wcfg := cfg.DefaultConfig()
+	wcfg.Mempool.RootDir = rootDir
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, wcfg)
+	defer cleanup()
+	mempool.InitWAL()
+
+	// 1. the txs are logged, and the committed ones removed
+	txs := types.Txs{[]byte("a"), []byte("b"), []byte("c")}
+	for _, tx := range txs {
+		require.NoError(t, mempool.CheckTx(tx, nil, TxInfo{}))
+	}
+	mempool.Update(1, txs[1:2], abciResponses(1, abci.CodeTypeOK), nil, nil)
+	walFilepath := mempool.wal.Path()
+
+	// 2. a crash leaves a truncated record, which is ignored
+	f, err := os.OpenFile(walFilepath, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.Write(walRecord(walRecordAdd, []byte("d"))[:2])
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// 3. the pending txs are checked again on restart, and the WAL compacted
+	mempool2, cleanup2 := newMempoolWithAppAndConfig(cc, wcfg)
+	defer cleanup2()
+	mempool2.InitWAL()
+	assert.Equal(t, types.Txs{txs[0], txs[2]}, mempool2.ReapMaxTxs(-1))
+
+	walTxs, records, err := readTxWAL(walFilepath)
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{txs[0], txs[2]}, walTxs)
+	assert.Equal(t, 2, records)
+
+	// 4. the committed txs are dropped
+	mempool2.CloseWAL()
+	mempool3, cleanup3 := newMempoolWithAppAndConfig(cc, wcfg)
+	defer cleanup3()
+	WithTxCommitted(func(tx types.Tx) bool { return bytes.Equal(tx, txs[0]) })(mempool3)
+	mempool3.InitWAL()
+	assert.Equal(t, types.Txs{txs[2]}, mempool3.ReapMaxTxs(-1))
+	mempool3.CloseWAL()
+
+	// 5. the txs rejected by the app are dropped
+	mempool4, cleanup4 := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(newPriorityApp()), wcfg)
+	defer cleanup4()
+	mempool4.InitWAL()
+	defer mempool4.CloseWAL()
+	assert.Equal(t, 0, mempool4.Size())
+	walTxs, _, err = readTxWAL(walFilepath)
+	require.NoError(t, err)
+	assert.Empty(t, walTxs)
+}
+
 func TestMempoolMaxMsgSize(t *testing.T) {
 	app := kvstore.NewApplication()
 	cc := proxy.NewLocalClientCreator(app)
@@ -538,6 +600,115 @@ func TestMempoolTxsBytes(t *testing.T) {
 	assert.EqualValues(t, 0, mempool.TxsBytes())
 }
 
+func TestMempoolTTL(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	config := cfg.ResetTestRoot("mempool_test")
+	config.Mempool.TTLNumBlocks = 2
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup()
+
+	eventBus := types.NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	defer eventBus.Stop()
+	WithEventBus(eventBus)(mempool)
+	sub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryEvictedTx, 10)
+	require.NoError(t, err)
+
+	// 1. txs are evicted after ttl_num_blocks blocks
+	checkTxs(t, mempool, 2, UnknownPeerID)
+	mempool.Update(1, nil, nil, nil, nil)
+	mempool.Update(2, nil, nil, nil, nil)
+	require.Equal(t, 2, mempool.Size())
+	checkTxs(t, mempool, 1, UnknownPeerID)
+	mempool.Update(3, nil, nil, nil, nil)
+	require.Equal(t, 1, mempool.Size())
+	mempool.Update(5, nil, nil, nil, nil)
+	require.Equal(t, 0, mempool.Size())
+
+	// 2. the evictions are published on the event bus
+	for i := 0; i < 3; i++ {
+		select {
+		case msg := <-sub.Out():
+			assert.Equal(t, EvictedExpired, msg.Data().(types.EventDataEvictedTx).Reason)
+		case <-time.After(time.Second):
+			t.Fatal("Expected an evicted tx event")
+		}
+	}
+
+	// 3. txs are evicted after ttl_duration
+	config.Mempool.TTLNumBlocks = 0
+	config.Mempool.TTLDuration = 50 * time.Millisecond
+	txs := checkTxs(t, mempool, 2, UnknownPeerID)
+	mempool.Update(6, nil, nil, nil, nil)
+	require.Equal(t, 2, mempool.Size())
+	time.Sleep(100 * time.Millisecond)
+	mempool.Update(7, nil, nil, nil, nil)
+	require.Equal(t, 0, mempool.Size())
+
+	// 4. an expired tx is removed from the cache and can be resubmitted
+	err = mempool.CheckTx(txs[0], nil, TxInfo{})
+	require.NoError(t, err)
+	require.Equal(t, 1, mempool.Size())
+}
+
+func TestMempoolQuotas(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(newPriorityApp())
+	config := cfg.ResetTestRoot("mempool_test")
+	config.Mempool.MaxTxsPerPeer = 2
+	config.Mempool.MaxTxsPerSender = 2
+	mempool, cleanup := newMempoolWithAppAndConfig(cc, config)
+	defer cleanup()
+
+	checkTx := func(tx types.Tx, peerID uint16, peerP2PID p2p.ID) (*abci.ResponseCheckTx, error) {
+		var res *abci.ResponseCheckTx
+		err := mempool.CheckTx(tx, func(r *abci.Response) { res = r.GetCheckTx() },
+			TxInfo{SenderID: peerID, SenderP2PID: peerP2PID})
+		return res, err
+	}
+
+	// 1. a peer can't send more than max_txs_per_peer txs
+	for i := 0; i < 2; i++ {
+		_, err := checkTx(priorityTx("", 0, i), 1, "peer1")
+		require.NoError(t, err)
+	}
+	_, err := checkTx(priorityTx("", 0, 2), 1, "peer1")
+	assert.IsType(t, ErrPeerQuota{}, err)
+
+	// the id of a removed peer, reused for another peer, has a new quota
+	_, err = checkTx(priorityTx("", 0, 6), 1, "peer2")
+	require.NoError(t, err)
+	_, err = checkTx(priorityTx("", 0, 7), 1, "peer2")
+	require.NoError(t, err)
+
+	// 2. the txs submitted through the RPC are not limited
+	for i := 3; i < 6; i++ {
+		_, err := checkTx(priorityTx("", 0, i), UnknownPeerID, "")
+		require.NoError(t, err)
+	}
+
+	// 3. a sender can't have more than max_txs_per_sender txs
+	for i := 0; i < 2; i++ {
+		res, err := checkTx(priorityTx("a", 0, i), UnknownPeerID, "")
+		require.NoError(t, err)
+		require.Empty(t, res.MempoolError)
+	}
+	res, err := checkTx(priorityTx("a", 0, 2), UnknownPeerID, "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.MempoolError)
+	assert.Equal(t, 9, mempool.Size())
+
+	// 4. the quotas are freed by the committed txs
+	mempool.Update(1, types.Txs{priorityTx("", 0, 0), priorityTx("a", 0, 0)},
+		abciResponses(2, abci.CodeTypeOK), nil, nil)
+	_, err = checkTx(priorityTx("", 0, 2), 1, "peer1")
+	require.NoError(t, err)
+	res, err = checkTx(priorityTx("a", 0, 2), UnknownPeerID, "")
+	require.NoError(t, err)
+	assert.Empty(t, res.MempoolError)
+}
+
 // This will non-deterministically catch some concurrency failures like
 // https://github.com/tendermint/tendermint/issues/3509
 // TODO: all of the tests should probably also run using the remote proxy app
@@ -37,6 +37,25 @@ func (e ErrMempoolIsFull) Error() string {
 		e.txsBytes, e.maxTxsBytes)
 }
 
+// ErrPeerQuota means the peer sent too many txs still in the mempool
+type ErrPeerQuota struct {
+	max int
+}
+
+func (e ErrPeerQuota) Error() string {
+	return fmt.Sprintf("peer reached its quota of %d txs in the mempool", e.max)
+}
+
+// ErrSenderQuota means the sender of the tx has too many txs in the mempool
+type ErrSenderQuota struct {
+	sender string
+	max    int
+}
+
+func (e ErrSenderQuota) Error() string {
+	return fmt.Sprintf("sender %s reached its quota of %d txs in the mempool", e.sender, e.max)
+}
+
 // ErrPreCheck is returned when tx is too big
 type ErrPreCheck struct {
 	Reason error
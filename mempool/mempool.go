@@ -69,9 +69,11 @@ type Mempool interface {
 	TxsBytes() int64
 
 	// InitWAL creates a directory for the WAL file and opens a file itself.
+	// The txs left pending in the WAL by the last run are checked again and
+	// added back to the mempool.
 	InitWAL()
 
-	// CloseWAL closes and discards the underlying WAL file.
+	// CloseWAL compacts, closes and discards the underlying WAL file.
 	// Any further writes will not be relayed to disk.
 	CloseWAL()
 }
@@ -88,6 +90,10 @@ type PreCheckFunc func(types.Tx) error
 // transaction doesn't require more gas than available for the block.
 type PostCheckFunc func(types.Tx, *abci.ResponseCheckTx) error
 
+// TxCommittedFunc returns true if the tx was committed, e.g. if it is in the
+// tx index.
+type TxCommittedFunc func(types.Tx) bool
+
 // TxInfo are parameters that get passed when attempting to add a tx to the
 // mempool.
 type TxInfo struct {
@@ -0,0 +1,396 @@
+package mempool
+
+import (
+	"container/heap"
+	"sync"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/clist"
+	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/types"
+)
+
+//--------------------------------------------------------------------------------
+
+// PriorityMempool is an in-memory pool for transactions, reaped by the
+// priority the application returns in ResponseCheckTx. Transactions with the
+// same priority are reaped in the order they were checked, and the
+// transactions of a sender (ResponseCheckTx.Sender) are always reaped in the
+// order they were checked, so that their nonces stay in sequence.
+//
+// When the mempool is full, the transactions with the lowest priority are
+// evicted to make room for a transaction with a higher priority. The last
+// transaction of a sender is evicted first.
+//
+// Everything else (the cache, the WAL, the rechecks, the quotas and the
+// expiration) is the one of the CListMempool: the transactions are kept in
+// its concurrent linked-list, in the order they were checked, which is
+// traversed by the reactor to gossip them and by the rechecks, which keeps the
+// transactions of a sender in sequence.
+type PriorityMempool struct {
+	*CListMempool
+
+	// The txs grouped by sender, kept up to date as the txs are added to the
+	// list and removed from it. A tx without sender is a group of its own.
+	mtx       sync.Mutex
+	order     int64                        // order of the next tx added
+	bySender  map[string]*txGroup          // sender -> group
+	byElem    map[*clist.CElement]*txGroup // tx -> group
+	reapHeap  reapGroupHeap                // groups by their first tx
+	evictHeap evictGroupHeap               // groups by their last tx
+}
+
+var _ Mempool = &PriorityMempool{}
+
+// PriorityMempoolOption sets an optional parameter on the mempool.
+type PriorityMempoolOption func(*PriorityMempool)
+
+// NewPriorityMempool returns a new prioritized mempool with the given
+// configuration and connection to an application.
+func NewPriorityMempool(
+	config *cfg.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	options ...PriorityMempoolOption,
+) *PriorityMempool {
+	mempool := &PriorityMempool{
+		CListMempool: NewCListMempool(config, proxyAppConn, height),
+		bySender:     make(map[string]*txGroup),
+		byElem:       make(map[*clist.CElement]*txGroup),
+	}
+	mempool.makeRoom = mempool.evictionVictims
+	mempool.reapOrder = mempool.txsByPriority
+	mempool.txAdded = mempool.addToGroup
+	mempool.txRemoved = mempool.removeFromGroup
+	mempool.txUpdated = mempool.fixGroup
+	for _, option := range options {
+		option(mempool)
+	}
+	return mempool
+}
+
+// PriorityWithPreCheck sets a filter for the mempool to reject a tx if f(tx)
+// returns false. This is ran before CheckTx.
+func PriorityWithPreCheck(f PreCheckFunc) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { WithPreCheck(f)(mem.CListMempool) }
+}
+
+// PriorityWithPostCheck sets a filter for the mempool to reject a tx if
+// f(tx) returns false. This is ran after CheckTx.
+func PriorityWithPostCheck(f PostCheckFunc) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { WithPostCheck(f)(mem.CListMempool) }
+}
+
+// PriorityWithMetrics sets the metrics.
+func PriorityWithMetrics(metrics *Metrics) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { WithMetrics(metrics)(mem.CListMempool) }
+}
+
+// PriorityWithTxCommitted sets the function used to drop the committed txs of
+// the WAL when it is replayed.
+func PriorityWithTxCommitted(f TxCommittedFunc) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { WithTxCommitted(f)(mem.CListMempool) }
+}
+
+// PriorityWithEventBus sets the event bus the evicted txs are published on.
+func PriorityWithEventBus(eventBus types.MempoolEventPublisher) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { WithEventBus(eventBus)(mem.CListMempool) }
+}
+
+// addToGroup appends the tx of e to the group of its sender.
+func (mem *PriorityMempool) addToGroup(e *clist.CElement) {
+	mem.mtx.Lock()
+	defer mem.mtx.Unlock()
+
+	memTx := e.Value.(*mempoolTx)
+	group, ok := mem.bySender[memTx.sender]
+	if !ok || memTx.sender == "" {
+		group = &txGroup{sender: memTx.sender}
+		if memTx.sender != "" {
+			mem.bySender[memTx.sender] = group
+		}
+	}
+	group.txs = append(group.txs, queuedTx{elem: e, order: mem.order})
+	mem.order++
+	mem.byElem[e] = group
+
+	if len(group.txs) == 1 {
+		heap.Push(&mem.reapHeap, group)
+		heap.Push(&mem.evictHeap, group)
+	} else {
+		heap.Fix(&mem.evictHeap, group.evictIndex)
+	}
+}
+
+// removeFromGroup removes the tx of e from the group of its sender, and the
+// group once it is empty.
+func (mem *PriorityMempool) removeFromGroup(e *clist.CElement) {
+	mem.mtx.Lock()
+	defer mem.mtx.Unlock()
+
+	group, ok := mem.byElem[e]
+	if !ok {
+		return
+	}
+	delete(mem.byElem, e)
+	// The committed txs are the first ones of their sender.
+	for i := range group.txs {
+		if group.txs[i].elem == e {
+			group.txs = append(group.txs[:i], group.txs[i+1:]...)
+			break
+		}
+	}
+
+	if len(group.txs) == 0 {
+		heap.Remove(&mem.reapHeap, group.reapIndex)
+		heap.Remove(&mem.evictHeap, group.evictIndex)
+		if group.sender != "" {
+			delete(mem.bySender, group.sender)
+		}
+		return
+	}
+	heap.Fix(&mem.reapHeap, group.reapIndex)
+	heap.Fix(&mem.evictHeap, group.evictIndex)
+}
+
+// fixGroup restores the order of the heaps after the priority of the tx of e
+// changed.
+func (mem *PriorityMempool) fixGroup(e *clist.CElement) {
+	mem.mtx.Lock()
+	defer mem.mtx.Unlock()
+
+	if group, ok := mem.byElem[e]; ok {
+		heap.Fix(&mem.reapHeap, group.reapIndex)
+		heap.Fix(&mem.evictHeap, group.evictIndex)
+	}
+}
+
+// evictionVictims returns the txs to evict to make room for memTx, if the
+// mempool is full: the txs with the lowest priority, lower than the one of
+// memTx, the latest one among equals. Only the last tx of a sender can be
+// evicted, so that the remaining ones stay in sequence, and the txs of the
+// sender of memTx are never evicted.
+//
+// The victims are only removed from their groups once they are evicted: the
+// groups are trimmed while the victims are picked, and restored after.
+func (mem *PriorityMempool) evictionVictims(memTx *mempoolTx) ([]*clist.CElement, error) {
+	var (
+		memSize  = mem.Size()
+		txsBytes = mem.TxsBytes()
+		txSize   = int64(len(memTx.tx))
+	)
+	isFull := func() bool {
+		return memSize >= mem.config.Size || txSize+txsBytes > mem.config.MaxTxsBytes
+	}
+	if !isFull() {
+		return nil, nil
+	}
+
+	mem.mtx.Lock()
+	defer mem.mtx.Unlock()
+
+	var trimmed, removed []*txGroup
+	defer func() {
+		for _, group := range trimmed {
+			group.evicting = 0
+			if group.evictIndex >= 0 {
+				heap.Fix(&mem.evictHeap, group.evictIndex)
+			}
+		}
+		for _, group := range removed {
+			heap.Push(&mem.evictHeap, group)
+		}
+	}()
+	if group, ok := mem.bySender[memTx.sender]; ok && memTx.sender != "" {
+		heap.Remove(&mem.evictHeap, group.evictIndex)
+		removed = append(removed, group)
+	}
+
+	var victims []*clist.CElement
+	for isFull() {
+		if mem.evictHeap.Len() == 0 || mem.evictHeap[0].victim().tx().priority >= memTx.priority {
+			return nil, ErrMempoolIsFull{
+				mem.Size(), mem.config.Size,
+				mem.TxsBytes(), mem.config.MaxTxsBytes}
+		}
+
+		lowest := mem.evictHeap[0]
+		e := lowest.txs[len(lowest.txs)-1-lowest.evicting].elem
+		if lowest.evicting == 0 {
+			trimmed = append(trimmed, lowest)
+		}
+		lowest.evicting++
+		if lowest.evicting == len(lowest.txs) {
+			heap.Pop(&mem.evictHeap)
+			removed = append(removed, lowest)
+		} else {
+			heap.Fix(&mem.evictHeap, 0)
+		}
+
+		victims = append(victims, e)
+		memSize--
+		txsBytes -= int64(len(e.Value.(*mempoolTx).tx))
+	}
+	return victims, nil
+}
+
+// txsByPriority returns the txs of the mempool in the order they are reaped:
+// by decreasing priority, in the order they were checked among equals, and
+// each tx after the previous txs of its sender.
+func (mem *PriorityMempool) txsByPriority() []*mempoolTx {
+	mem.mtx.Lock()
+	defer mem.mtx.Unlock()
+
+	// The reap heap is a valid heap of cursors at the first tx of each group.
+	h := make(groupCursorHeap, len(mem.reapHeap))
+	for i, group := range mem.reapHeap {
+		h[i] = &groupCursor{group: group}
+	}
+
+	txs := make([]*mempoolTx, 0, len(mem.byElem))
+	for h.Len() > 0 {
+		cursor := h[0]
+		txs = append(txs, cursor.tx())
+		cursor.next++
+		if cursor.next == len(cursor.group.txs) {
+			heap.Pop(&h)
+		} else {
+			heap.Fix(&h, 0)
+		}
+	}
+	return txs
+}
+
+//--------------------------------------------------------------------------------
+
+// queuedTx is a tx of the mempool with the order it was added to the list.
+type queuedTx struct {
+	elem  *clist.CElement
+	order int64
+}
+
+func (q queuedTx) tx() *mempoolTx { return q.elem.Value.(*mempoolTx) }
+
+// txGroup is the queue of the txs of a sender, in the order they were checked.
+type txGroup struct {
+	sender string
+	txs    []queuedTx
+
+	// number of last txs picked as eviction victims
+	evicting int
+
+	// indexes in the heaps of the PriorityMempool, -1 when removed
+	reapIndex  int
+	evictIndex int
+}
+
+// victim returns the tx of the group to evict next.
+func (g *txGroup) victim() queuedTx { return g.txs[len(g.txs)-1-g.evicting] }
+
+// reapGroupHeap is a max-heap of txGroups by the priority of their first tx,
+// the earliest first among equals.
+type reapGroupHeap []*txGroup
+
+var _ heap.Interface = (*reapGroupHeap)(nil)
+
+func (h reapGroupHeap) Len() int { return len(h) }
+
+func (h reapGroupHeap) Less(i, j int) bool {
+	return reapsBefore(h[i].txs[0], h[j].txs[0])
+}
+
+func (h reapGroupHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].reapIndex = i
+	h[j].reapIndex = j
+}
+
+func (h *reapGroupHeap) Push(x interface{}) {
+	group := x.(*txGroup)
+	group.reapIndex = len(*h)
+	*h = append(*h, group)
+}
+
+func (h *reapGroupHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	x.reapIndex = -1
+	*h = old[:n-1]
+	return x
+}
+
+// evictGroupHeap is a min-heap of txGroups by the priority of their victim,
+// the latest first among equals.
+type evictGroupHeap []*txGroup
+
+var _ heap.Interface = (*evictGroupHeap)(nil)
+
+func (h evictGroupHeap) Len() int { return len(h) }
+
+func (h evictGroupHeap) Less(i, j int) bool {
+	return reapsBefore(h[j].victim(), h[i].victim())
+}
+
+func (h evictGroupHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].evictIndex = i
+	h[j].evictIndex = j
+}
+
+func (h *evictGroupHeap) Push(x interface{}) {
+	group := x.(*txGroup)
+	group.evictIndex = len(*h)
+	*h = append(*h, group)
+}
+
+func (h *evictGroupHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	x.evictIndex = -1
+	*h = old[:n-1]
+	return x
+}
+
+// groupCursor is the next tx to reap of a txGroup.
+type groupCursor struct {
+	group *txGroup
+	next  int
+}
+
+func (c *groupCursor) tx() *mempoolTx { return c.group.txs[c.next].tx() }
+
+// groupCursorHeap is a max-heap of groupCursors by the priority of their tx,
+// the earliest first among equals.
+type groupCursorHeap []*groupCursor
+
+var _ heap.Interface = (*groupCursorHeap)(nil)
+
+func (h groupCursorHeap) Len() int { return len(h) }
+
+func (h groupCursorHeap) Less(i, j int) bool {
+	return reapsBefore(h[i].group.txs[h[i].next], h[j].group.txs[h[j].next])
+}
+
+func (h groupCursorHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *groupCursorHeap) Push(x interface{}) { *h = append(*h, x.(*groupCursor)) }
+
+func (h *groupCursorHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// reapsBefore returns true if a is reaped before b: with a higher priority,
+// or checked earlier among equals.
+func reapsBefore(a, b queuedTx) bool {
+	pa, pb := a.tx().priority, b.tx().priority
+	if pa != pb {
+		return pa > pb
+	}
+	return a.order < b.order
+}
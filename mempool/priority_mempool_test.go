@@ -0,0 +1,258 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proxy"
+	"github.com/tendermint/tendermint/types"
+)
+
+// priorityApp accepts txs of the form sender/priority/nonce and returns
+// their sender and priority. A priority can be changed for the rechecks.
+type priorityApp struct {
+	abci.BaseApplication
+
+	priorities map[string]int64
+}
+
+func newPriorityApp() *priorityApp {
+	return &priorityApp{priorities: make(map[string]int64)}
+}
+
+func (app *priorityApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	parts := strings.Split(string(req.Tx), "/")
+	if len(parts) != 3 {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	priority, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	if p, ok := app.priorities[string(req.Tx)]; ok {
+		priority = p
+	}
+	return abci.ResponseCheckTx{Code: abci.CodeTypeOK, Sender: parts[0], Priority: priority, GasWanted: 1}
+}
+
+func priorityTx(sender string, priority int64, nonce int) types.Tx {
+	return types.Tx(fmt.Sprintf("%s/%d/%d", sender, priority, nonce))
+}
+
+func newPriorityMempoolWithApp(app abci.Application, config *cfg.Config) (*PriorityMempool, cleanupFunc) {
+	appConnMem, _ := proxy.NewLocalClientCreator(app).NewABCIClient()
+	appConnMem.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "mempool"))
+	err := appConnMem.Start()
+	if err != nil {
+		panic(err)
+	}
+	mempool := NewPriorityMempool(config.Mempool, appConnMem, 0)
+	mempool.SetLogger(log.TestingLogger())
+	return mempool, func() { os.RemoveAll(config.RootDir) }
+}
+
+func checkPriorityTxs(t *testing.T, mempool Mempool, txs ...types.Tx) []*abci.ResponseCheckTx {
+	responses := make([]*abci.ResponseCheckTx, len(txs))
+	for i, tx := range txs {
+		i := i
+		err := mempool.CheckTx(tx, func(res *abci.Response) {
+			responses[i] = res.GetCheckTx()
+		}, TxInfo{})
+		require.NoError(t, err)
+	}
+	return responses
+}
+
+func TestPriorityMempoolReap(t *testing.T) {
+	mempool, cleanup := newPriorityMempoolWithApp(newPriorityApp(), cfg.ResetTestRoot("mempool_test"))
+	defer cleanup()
+
+	var (
+		a0 = priorityTx("a", 1, 0)
+		a1 = priorityTx("a", 5, 1)
+		b0 = priorityTx("b", 3, 0)
+		c0 = priorityTx("c", 3, 0)
+		d0 = priorityTx("", 4, 0)
+		d1 = priorityTx("", 4, 1)
+	)
+	checkPriorityTxs(t, mempool, a0, a1, b0, c0, d0, d1)
+	require.Equal(t, 6, mempool.Size())
+
+	// by priority, in the order they were checked among equals, and the txs
+	// of a sender in sequence: a1 comes after a0 which has the lowest priority
+	expected := types.Txs{d0, d1, b0, c0, a0, a1}
+	assert.Equal(t, expected, mempool.ReapMaxTxs(-1))
+	assert.Equal(t, expected[:3], mempool.ReapMaxTxs(3))
+	assert.Equal(t, expected[:2], mempool.ReapMaxBytesMaxGas(-1, 2))
+
+	// the reactor gossips the txs in the order they were checked
+	assert.Equal(t, a0, mempool.TxsFront().Value.(*mempoolTx).tx)
+}
+
+func TestPriorityMempoolRecheck(t *testing.T) {
+	app := newPriorityApp()
+	mempool, cleanup := newPriorityMempoolWithApp(app, cfg.ResetTestRoot("mempool_test"))
+	defer cleanup()
+
+	var (
+		a0 = priorityTx("a", 1, 0)
+		b0 = priorityTx("b", 2, 0)
+		c0 = priorityTx("c", 3, 0)
+	)
+	checkPriorityTxs(t, mempool, a0, b0, c0)
+	assert.Equal(t, types.Txs{c0, b0, a0}, mempool.ReapMaxTxs(-1))
+
+	// the priorities are updated by the recheck after a block
+	app.priorities[string(a0)] = 10
+	mempool.Lock()
+	err := mempool.Update(1, types.Txs{b0}, abciResponses(1, abci.CodeTypeOK), nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{a0, c0}, mempool.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolSenderGroups(t *testing.T) {
+	mempool, cleanup := newPriorityMempoolWithApp(newPriorityApp(), cfg.ResetTestRoot("mempool_test"))
+	defer cleanup()
+
+	var (
+		a0 = priorityTx("a", 1, 0)
+		a1 = priorityTx("a", 4, 1)
+		b0 = priorityTx("b", 2, 0)
+		b1 = priorityTx("b", 3, 1)
+	)
+	checkPriorityTxs(t, mempool, a0, a1, b0)
+	require.Len(t, mempool.bySender, 2)
+
+	// the groups follow the committed txs
+	mempool.Lock()
+	err := mempool.Update(1, types.Txs{a0, b0}, abciResponses(2, abci.CodeTypeOK), nil, nil)
+	mempool.Unlock()
+	require.NoError(t, err)
+	assert.Len(t, mempool.bySender, 1)
+	assert.Len(t, mempool.byElem, 1)
+	assert.Equal(t, types.Txs{a1}, mempool.ReapMaxTxs(-1))
+
+	// and the new txs
+	checkPriorityTxs(t, mempool, b1)
+	assert.Equal(t, types.Txs{a1, b1}, mempool.ReapMaxTxs(-1))
+
+	mempool.Flush()
+	assert.Empty(t, mempool.bySender)
+	assert.Empty(t, mempool.byElem)
+	assert.Zero(t, mempool.reapHeap.Len())
+	assert.Zero(t, mempool.evictHeap.Len())
+	assert.Empty(t, mempool.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolEviction(t *testing.T) {
+	config := cfg.ResetTestRoot("mempool_test")
+	config.Mempool.Size = 3
+	mempool, cleanup := newPriorityMempoolWithApp(newPriorityApp(), config)
+	defer cleanup()
+
+	var (
+		a0 = priorityTx("a", 1, 0)
+		a1 = priorityTx("a", 2, 1)
+		b0 = priorityTx("b", 1, 0)
+	)
+	checkPriorityTxs(t, mempool, a0, a1, b0)
+	require.Equal(t, 3, mempool.Size())
+
+	// a tx without a higher priority is rejected
+	res := checkPriorityTxs(t, mempool, priorityTx("c", 1, 0))
+	assert.NotEmpty(t, res[0].MempoolError)
+	assert.Equal(t, 3, mempool.Size())
+
+	// the latest of the lowest priority txs is evicted: a0 can't be evicted
+	// before a1, so b0 is
+	c0 := priorityTx("c", 3, 0)
+	res = checkPriorityTxs(t, mempool, c0)
+	assert.Empty(t, res[0].MempoolError)
+	assert.Equal(t, types.Txs{c0, a0, a1}, mempool.ReapMaxTxs(-1))
+
+	// then the last tx of a sender
+	d0 := priorityTx("d", 3, 0)
+	checkPriorityTxs(t, mempool, d0)
+	assert.Equal(t, types.Txs{c0, d0, a0}, mempool.ReapMaxTxs(-1))
+
+	// the txs of the sender are never evicted for its next tx
+	res = checkPriorityTxs(t, mempool, priorityTx("c", 4, 1))
+	assert.Empty(t, res[0].MempoolError)
+	assert.Equal(t, types.Txs{c0, priorityTx("c", 4, 1), d0}, mempool.ReapMaxTxs(-1))
+	res = checkPriorityTxs(t, mempool, priorityTx("c", 5, 2))
+	assert.Empty(t, res[0].MempoolError)
+	assert.Equal(t, types.Txs{c0, priorityTx("c", 4, 1), priorityTx("c", 5, 2)}, mempool.ReapMaxTxs(-1))
+	res = checkPriorityTxs(t, mempool, priorityTx("c", 6, 3))
+	assert.NotEmpty(t, res[0].MempoolError)
+
+	// an evicted tx is removed from the cache
+	err := mempool.CheckTx(b0, nil, TxInfo{})
+	assert.NoError(t, err)
+}
+
+func TestPriorityMempoolEvictionMaxTxsBytes(t *testing.T) {
+	config := cfg.ResetTestRoot("mempool_test")
+	config.Mempool.MaxTxsBytes = 10
+	mempool, cleanup := newPriorityMempoolWithApp(newPriorityApp(), config)
+	defer cleanup()
+
+	var (
+		a0 = priorityTx("a", 1, 0)
+		b0 = priorityTx("b", 2, 0)
+		c0 = priorityTx("c", 3, 0)
+	)
+	checkPriorityTxs(t, mempool, a0, b0)
+	require.EqualValues(t, 10, mempool.TxsBytes())
+
+	// a0 is evicted to fit c0
+	checkPriorityTxs(t, mempool, c0)
+	assert.Equal(t, types.Txs{c0, b0}, mempool.ReapMaxTxs(-1))
+
+	// a tx larger than max_txs_bytes is rejected before CheckTx
+	err := mempool.CheckTx(types.Tx(strings.Repeat("x", 11)), nil, TxInfo{})
+	assert.IsType(t, ErrMempoolIsFull{}, err)
+}
+
+func TestPriorityMempoolTTL(t *testing.T) {
+	config := cfg.ResetTestRoot("mempool_test")
+	config.Mempool.TTLNumBlocks = 1
+	config.Mempool.Size = 1
+	mempool, cleanup := newPriorityMempoolWithApp(newPriorityApp(), config)
+	defer cleanup()
+
+	eventBus := types.NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	defer eventBus.Stop()
+	PriorityWithEventBus(eventBus)(mempool)
+	sub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryEvictedTx, 10)
+	require.NoError(t, err)
+
+	// the evictions for priority and the expired txs are published
+	checkPriorityTxs(t, mempool, priorityTx("a", 1, 0), priorityTx("b", 2, 0))
+	mempool.Update(1, nil, nil, nil, nil)
+	require.Equal(t, 1, mempool.Size())
+	mempool.Update(2, nil, nil, nil, nil)
+	require.Equal(t, 0, mempool.Size())
+
+	for _, reason := range []string{EvictedForPriority, EvictedExpired} {
+		select {
+		case msg := <-sub.Out():
+			assert.Equal(t, reason, msg.Data().(types.EventDataEvictedTx).Reason)
+		case <-time.After(time.Second):
+			t.Fatal("Expected an evicted tx event")
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package mempool
+
+import (
+	"sync"
+	"time"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/p2p"
+)
+
+// Reasons of the eviction of a tx from the mempool, as published in
+// types.EventDataEvictedTx and labelled in the EvictedTxs metric.
+const (
+	// EvictedForPriority is the reason of a tx evicted to make room for a
+	// higher priority tx.
+	EvictedForPriority = "priority"
+	// EvictedExpired is the reason of a tx which outlived the ttl_num_blocks
+	// or ttl_duration of the config.
+	EvictedExpired = "expired"
+)
+
+// isExpired returns true if memTx outlived the ttl_num_blocks or ttl_duration
+// of config at the given height and time.
+func isExpired(config *cfg.MempoolConfig, memTx *mempoolTx, height int64, now time.Time) bool {
+	if config.TTLNumBlocks > 0 && height-memTx.Height() > config.TTLNumBlocks {
+		return true
+	}
+	if config.TTLDuration > 0 && now.Sub(memTx.timestamp) > config.TTLDuration {
+		return true
+	}
+	return false
+}
+
+// txQuotas counts the txs in the mempool per peer and per sender, to enforce
+// the max_txs_per_peer and max_txs_per_sender of the config. The peers are
+// counted by p2p.ID, since the uint16 ids of the peers are reused once they
+// are removed, while their txs stay in the mempool. The txs submitted through
+// the RPC (no p2p.ID) and the txs without sender are not counted.
+type txQuotas struct {
+	mtx     sync.Mutex
+	config  *cfg.MempoolConfig
+	peers   map[p2p.ID]int
+	senders map[string]int
+}
+
+func newTxQuotas(config *cfg.MempoolConfig) *txQuotas {
+	return &txQuotas{
+		config:  config,
+		peers:   make(map[p2p.ID]int),
+		senders: make(map[string]int),
+	}
+}
+
+// checkPeer returns ErrPeerQuota if the peer reached its quota.
+func (q *txQuotas) checkPeer(peerID p2p.ID) error {
+	if q.config.MaxTxsPerPeer == 0 || peerID == "" {
+		return nil
+	}
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if q.peers[peerID] >= q.config.MaxTxsPerPeer {
+		return ErrPeerQuota{q.config.MaxTxsPerPeer}
+	}
+	return nil
+}
+
+// check returns ErrPeerQuota or ErrSenderQuota if the peer or the sender of
+// memTx reached its quota.
+func (q *txQuotas) check(memTx *mempoolTx) error {
+	if err := q.checkPeer(memTx.peerP2PID); err != nil {
+		return err
+	}
+	if q.config.MaxTxsPerSender == 0 || memTx.sender == "" {
+		return nil
+	}
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if q.senders[memTx.sender] >= q.config.MaxTxsPerSender {
+		return ErrSenderQuota{memTx.sender, q.config.MaxTxsPerSender}
+	}
+	return nil
+}
+
+// add counts memTx, added to the mempool.
+func (q *txQuotas) add(memTx *mempoolTx) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if memTx.peerP2PID != "" {
+		q.peers[memTx.peerP2PID]++
+	}
+	if memTx.sender != "" {
+		q.senders[memTx.sender]++
+	}
+}
+
+// remove uncounts memTx, removed from the mempool.
+func (q *txQuotas) remove(memTx *mempoolTx) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if memTx.peerP2PID != "" {
+		if q.peers[memTx.peerP2PID]--; q.peers[memTx.peerP2PID] <= 0 {
+			delete(q.peers, memTx.peerP2PID)
+		}
+	}
+	if memTx.sender != "" {
+		if q.senders[memTx.sender]--; q.senders[memTx.sender] <= 0 {
+			delete(q.senders, memTx.sender)
+		}
+	}
+}
+
+// reset uncounts every tx, when the mempool is flushed.
+func (q *txQuotas) reset() {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	q.peers = make(map[p2p.ID]int)
+	q.senders = make(map[string]int)
+}
@@ -36,10 +36,23 @@ const (
 type Reactor struct {
 	p2p.BaseReactor
 	config  *cfg.MempoolConfig
-	mempool *CListMempool
+	mempool gossipMempool
 	ids     *mempoolIDs
 }
 
+// gossipMempool is a mempool whose txs are gossiped by the Reactor, by
+// traversing its concurrent linked-list of *mempoolTx.
+type gossipMempool interface {
+	Mempool
+
+	SetLogger(log.Logger)
+	TxsFront() *clist.CElement
+	TxsWaitChan() <-chan struct{}
+}
+
+var _ gossipMempool = (*CListMempool)(nil)
+var _ gossipMempool = (*PriorityMempool)(nil)
+
 type mempoolIDs struct {
 	mtx       sync.RWMutex
 	peerMap   map[p2p.ID]uint16
@@ -103,8 +116,9 @@ func newMempoolIDs() *mempoolIDs {
 	}
 }
 
-// NewReactor returns a new Reactor with the given config and mempool.
-func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool) *Reactor {
+// NewReactor returns a new Reactor with the given config and mempool, a
+// CListMempool or a PriorityMempool.
+func NewReactor(config *cfg.MempoolConfig, mempool gossipMempool) *Reactor {
 	memR := &Reactor{
 		config:  config,
 		mempool: mempool,
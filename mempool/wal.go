@@ -0,0 +1,303 @@
+package mempool
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	auto "github.com/tendermint/tendermint/libs/autofile"
+	"github.com/tendermint/tendermint/libs/clist"
+	"github.com/tendermint/tendermint/libs/log"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// Types of the records of the WAL: a tx added to the mempool, followed by
+	// the tx, and a tx removed from the mempool, followed by its txKey.
+	walRecordAdd    = byte(0x01)
+	walRecordRemove = byte(0x02)
+
+	// The WAL is compacted when it holds more than walCompactionMinRecords
+	// records and twice as many records as txs in the mempool.
+	walCompactionMinRecords = 1000
+
+	// A WAL which can't be read, e.g. the WAL of a previous version, is moved
+	// to its path with this suffix.
+	walLegacySuffix = ".legacy"
+)
+
+// txWAL logs the txs added to and removed from the mempool, so that the txs
+// pending at a restart can be replayed. Each record is a record type, the
+// uvarint length of its data and the data.
+type txWAL struct {
+	mtx     sync.Mutex
+	af      *auto.AutoFile
+	records int // written since the last compaction
+}
+
+// openTxWAL creates the WAL directory and returns the WAL with the txs which
+// were pending in the mempool when it was last written, in the order they
+// were added.
+//
+// A WAL which can't be read is moved aside, and its txs are read with the
+// format of the previous versions (the txs separated by newlines), so that
+// they are replayed and written to the new WAL.
+func openTxWAL(walDir string, logger log.Logger) (*txWAL, types.Txs, error) {
+	if err := tmos.EnsureDir(walDir, 0700); err != nil {
+		return nil, nil, errors.Wrap(err, "Error ensuring WAL dir")
+	}
+	path := filepath.Join(walDir, "wal")
+	txs, records, err := readTxWAL(path)
+	if err != nil {
+		legacyPath := path + walLegacySuffix
+		if err := os.Rename(path, legacyPath); err != nil {
+			return nil, nil, errors.Wrap(err, "Error moving unreadable WAL file")
+		}
+		logger.Error("Moved unreadable WAL file, reading it as a legacy WAL", "err", err, "path", legacyPath)
+
+		txs, err = readLegacyTxWAL(legacyPath)
+		if err != nil {
+			logger.Error("Error reading legacy WAL file, skipping its txs", "err", err, "path", legacyPath)
+		}
+		records = 0
+	}
+	af, err := auto.OpenAutoFile(path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Error opening WAL file")
+	}
+	return &txWAL{af: af, records: records}, txs, nil
+}
+
+// readTxWAL returns the txs added and not removed by the records of the WAL
+// file, and the number of records. A missing file has no txs, and a truncated
+// last record, written during a crash, is ignored.
+func readTxWAL(path string) (types.Txs, int, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, 0, nil
+	} else if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+
+	var (
+		r       = bufio.NewReader(f)
+		txs     []types.Tx
+		pending = make(map[[sha256.Size]byte]int) // txKey -> index in txs
+		records int
+	)
+	for {
+		recordType, data, err := readWALRecord(r)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		} else if err != nil {
+			return nil, 0, err
+		}
+		records++
+
+		switch recordType {
+		case walRecordAdd:
+			key := txKey(data)
+			if _, ok := pending[key]; !ok {
+				pending[key] = len(txs)
+				txs = append(txs, data)
+			}
+		case walRecordRemove:
+			var key [sha256.Size]byte
+			copy(key[:], data)
+			if i, ok := pending[key]; ok {
+				txs[i] = nil
+				delete(pending, key)
+			}
+		}
+	}
+
+	res := make(types.Txs, 0, len(pending))
+	for _, tx := range txs {
+		if tx != nil {
+			res = append(res, tx)
+		}
+	}
+	return res, records, nil
+}
+
+// readLegacyTxWAL returns the txs of a WAL written by a previous version: the
+// txs separated by newlines.
+func readLegacyTxWAL(path string) (types.Txs, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		r   = bufio.NewReader(f)
+		txs types.Txs
+	)
+	for {
+		line, err := r.ReadBytes('\n')
+		if tx := bytes.TrimSuffix(line, []byte("\n")); len(tx) > 0 {
+			txs = append(txs, tx)
+		}
+		if err == io.EOF {
+			return txs, nil
+		} else if err != nil {
+			return txs, err
+		}
+	}
+}
+
+func readWALRecord(r *bufio.Reader) (byte, []byte, error) {
+	recordType, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	// checked before reading the data, which could look truncated
+	if recordType != walRecordAdd && recordType != walRecordRemove {
+		return 0, nil, errors.Errorf("unknown WAL record type %X", recordType)
+	}
+	size, err := binary.ReadUvarint(r)
+	if err == io.EOF {
+		return 0, nil, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return 0, nil, err
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return 0, nil, err
+	}
+	return recordType, data, nil
+}
+
+func walRecord(recordType byte, data []byte) []byte {
+	record := make([]byte, 1+binary.MaxVarintLen64+len(data))
+	record[0] = recordType
+	n := 1 + binary.PutUvarint(record[1:], uint64(len(data)))
+	n += copy(record[n:], data)
+	return record[:n]
+}
+
+// Add logs a tx added to the mempool.
+func (w *txWAL) Add(tx types.Tx) error {
+	return w.write(walRecord(walRecordAdd, tx))
+}
+
+// Remove logs a tx removed from the mempool.
+func (w *txWAL) Remove(tx types.Tx) error {
+	key := txKey(tx)
+	return w.write(walRecord(walRecordRemove, key[:]))
+}
+
+func (w *txWAL) write(record []byte) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	w.records++
+	_, err := w.af.Write(record)
+	return err
+}
+
+// NeedsCompaction returns true if the WAL holds too many records for the
+// size of the mempool.
+func (w *txWAL) NeedsCompaction(size int) bool {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	return w.records > walCompactionMinRecords && w.records > 2*size
+}
+
+// Compact replaces the WAL with the records of the given txs, the txs
+// currently in the mempool. The new WAL is written to a temporary file which
+// replaces the WAL once synced, so that a crash keeps either WAL.
+func (w *txWAL) Compact(txs types.Txs) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	tmpPath := w.af.Path + ".compact"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(f)
+	for _, tx := range txs {
+		if _, err := bw.Write(walRecord(walRecordAdd, tx)); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := w.af.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, w.af.Path); err != nil {
+		return err
+	}
+	af, err := auto.OpenAutoFile(w.af.Path)
+	if err != nil {
+		return err
+	}
+	w.af = af
+	w.records = len(txs)
+	return nil
+}
+
+// Close closes the WAL file.
+func (w *txWAL) Close() error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	return w.af.Close()
+}
+
+// Path returns the path of the WAL file.
+func (w *txWAL) Path() string {
+	return w.af.Path
+}
+
+// replayWAL checks the txs pending in the WAL against the application, to add
+// them back to the mempool. The committed txs, e.g. before a crash, are
+// dropped if txCommitted is set, and otherwise left to the application to
+// reject.
+func replayWAL(mem Mempool, txs types.Txs, txCommitted TxCommittedFunc, logger log.Logger) {
+	for _, tx := range txs {
+		if txCommitted != nil && txCommitted(tx) {
+			logger.Info("Dropped committed WAL transaction", "tx", txID(tx))
+			continue
+		}
+		if err := mem.CheckTx(tx, nil, TxInfo{SenderID: UnknownPeerID}); err != nil {
+			logger.Info("Dropped WAL transaction", "tx", txID(tx), "err", err)
+		}
+	}
+	if err := mem.FlushAppConn(); err != nil {
+		logger.Error("Error flushing the app connection", "err", err)
+	}
+	logger.Info("Replayed WAL", "txs", len(txs), "total", mem.Size())
+}
+
+// clistTxs returns the txs of the mempool list, in the order they were added.
+func clistTxs(txs *clist.CList) types.Txs {
+	res := make(types.Txs, 0, txs.Len())
+	for e := txs.Front(); e != nil; e = e.Next() {
+		res = append(res, e.Value.(*mempoolTx).tx)
+	}
+	return res
+}
@@ -0,0 +1,69 @@
+package mempool
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestTxWALCompaction(t *testing.T) {
+	walDir, err := ioutil.TempDir("", "mempool-wal")
+	require.NoError(t, err)
+	defer os.RemoveAll(walDir)
+
+	wal, txs, err := openTxWAL(walDir, log.TestingLogger())
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	// adding and removing txs grows the WAL until it needs a compaction
+	for i := 0; i < walCompactionMinRecords/2; i++ {
+		tx := types.Tx(fmt.Sprintf("tx%d", i))
+		require.NoError(t, wal.Add(tx))
+		require.NoError(t, wal.Remove(tx))
+	}
+	assert.False(t, wal.NeedsCompaction(0))
+	require.NoError(t, wal.Add(types.Tx("last")))
+	assert.True(t, wal.NeedsCompaction(0))
+	assert.False(t, wal.NeedsCompaction(walCompactionMinRecords))
+
+	require.NoError(t, wal.Compact(types.Txs{types.Tx("last")}))
+	assert.False(t, wal.NeedsCompaction(0))
+	require.NoError(t, wal.Close())
+
+	txs, records, err := readTxWAL(wal.Path())
+	require.NoError(t, err)
+	assert.Equal(t, types.Txs{types.Tx("last")}, txs)
+	assert.Equal(t, 1, records)
+}
+
+func TestTxWALLegacy(t *testing.T) {
+	walDir, err := ioutil.TempDir("", "mempool-wal")
+	require.NoError(t, err)
+	defer os.RemoveAll(walDir)
+
+	// the WAL of a previous version
+	legacy := []byte("tx1\nI am a tx\ntx3\n")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(walDir, "wal"), legacy, 0600))
+
+	wal, txs, err := openTxWAL(walDir, log.TestingLogger())
+	require.NoError(t, err)
+	defer wal.Close()
+	assert.Equal(t, types.Txs{types.Tx("tx1"), types.Tx("I am a tx"), types.Tx("tx3")}, txs)
+
+	// it is moved aside and the new WAL is empty
+	bz, err := ioutil.ReadFile(filepath.Join(walDir, "wal"+walLegacySuffix))
+	require.NoError(t, err)
+	assert.Equal(t, legacy, bz)
+	txs, records, err := readTxWAL(wal.Path())
+	require.NoError(t, err)
+	assert.Empty(t, txs)
+	assert.Zero(t, records)
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	_ "github.com/lib/pq" // postgres driver of the sql indexer
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -28,6 +29,8 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 	"github.com/tendermint/tendermint/libs/service"
+	lite "github.com/tendermint/tendermint/lite2"
+	litep2p "github.com/tendermint/tendermint/lite2/provider/p2p"
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/p2p/pex"
@@ -41,6 +44,8 @@ import (
 	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/kv"
 	"github.com/tendermint/tendermint/state/txindex/null"
+	"github.com/tendermint/tendermint/state/txindex/sql"
+	"github.com/tendermint/tendermint/statesync"
 	"github.com/tendermint/tendermint/store"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -151,6 +156,7 @@ type Option func(*Node)
 //  - CONSENSUS
 //  - EVIDENCE
 //  - PEX
+//  - STATESYNC
 func CustomReactors(reactors map[string]p2p.Reactor) Option {
 	return func(n *Node) {
 		for name, reactor := range reactors {
@@ -187,9 +193,12 @@ type Node struct {
 	// services
 	eventBus         *types.EventBus // pub/sub for services
 	stateDB          dbm.DB
-	blockStore       *store.BlockStore // store the blockchain to disk
-	bcReactor        p2p.Reactor       // for fast-syncing
-	mempoolReactor   *mempl.Reactor    // for gossipping transactions
+	blockStore       *store.BlockStore  // store the blockchain to disk
+	bcReactor        p2p.Reactor        // for fast-syncing
+	stateSyncReactor *statesync.Reactor // for hosting and restoring state sync snapshots
+	stateSync        bool               // whether the node should state sync on startup
+	stateSyncGenesis sm.State           // provides the genesis state for state sync
+	mempoolReactor   *mempl.Reactor     // for gossipping transactions
 	mempool          mempl.Mempool
 	consensusState   *cs.State      // latest consensus state
 	consensusReactor *cs.Reactor    // for participating in the consensus
@@ -198,6 +207,7 @@ type Node struct {
 	proxyApp         proxy.AppConns // connection to the application
 	rpcListeners     []net.Listener // rpc servers
 	txIndexer        txindex.TxIndexer
+	blockIndexer     txindex.BlockIndexer
 	indexerService   *txindex.IndexerService
 	prometheusSrv    *http.Server
 }
@@ -237,33 +247,62 @@ func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 }
 
 func createAndStartIndexerService(config *cfg.Config, dbProvider DBProvider,
-	eventBus *types.EventBus, logger log.Logger) (*txindex.IndexerService, txindex.TxIndexer, error) {
+	eventBus *types.EventBus, logger log.Logger,
+) (*txindex.IndexerService, txindex.TxIndexer, txindex.BlockIndexer, error) {
 
-	var txIndexer txindex.TxIndexer
-	switch config.TxIndex.Indexer {
-	case "kv":
-		store, err := dbProvider(&DBContext{"tx_index", config})
-		if err != nil {
-			return nil, nil, err
-		}
-		switch {
-		case config.TxIndex.IndexKeys != "":
-			txIndexer = kv.NewTxIndex(store, kv.IndexEvents(splitAndTrimEmpty(config.TxIndex.IndexKeys, ",", " ")))
-		case config.TxIndex.IndexAllKeys:
-			txIndexer = kv.NewTxIndex(store, kv.IndexAllEvents())
-		default:
-			txIndexer = kv.NewTxIndex(store)
+	var (
+		txIndexers    []txindex.TxIndexer
+		blockIndexers []txindex.BlockIndexer
+	)
+	for _, indexer := range config.TxIndex.Indexers() {
+		switch indexer {
+		case "kv":
+			store, err := dbProvider(&DBContext{"tx_index", config})
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			blockIndexers = append(blockIndexers, kv.NewBlockIndex(dbm.NewPrefixDB(store, []byte("block_events/"))))
+			switch {
+			case config.TxIndex.IndexKeys != "":
+				txIndexers = append(txIndexers,
+					kv.NewTxIndex(store, kv.IndexEvents(splitAndTrimEmpty(config.TxIndex.IndexKeys, ",", " "))))
+			case config.TxIndex.IndexAllKeys:
+				txIndexers = append(txIndexers, kv.NewTxIndex(store, kv.IndexAllEvents()))
+			default:
+				txIndexers = append(txIndexers, kv.NewTxIndex(store))
+			}
+		case "sql":
+			store, err := sql.Open(config.TxIndex.SQLDriver, config.TxIndex.SQLConn)
+			if err != nil {
+				return nil, nil, nil, errors.Wrap(err, "failed to open sql indexer")
+			}
+			txIndexers = append(txIndexers, sql.NewTxIndex(store))
+			blockIndexers = append(blockIndexers, sql.NewBlockIndex(store))
 		}
-	default:
+	}
+
+	var (
+		txIndexer    txindex.TxIndexer
+		blockIndexer txindex.BlockIndexer
+	)
+	switch len(txIndexers) {
+	case 0:
 		txIndexer = &null.TxIndex{}
+		blockIndexer = &null.BlockIndex{}
+	case 1:
+		txIndexer = txIndexers[0]
+		blockIndexer = blockIndexers[0]
+	default:
+		txIndexer = txindex.NewMultiTxIndex(txIndexers...)
+		blockIndexer = txindex.NewMultiBlockIndex(blockIndexers...)
 	}
 
-	indexerService := txindex.NewIndexerService(txIndexer, eventBus)
+	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus)
 	indexerService.SetLogger(logger.With("module", "txindex"))
 	if err := indexerService.Start(); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	return indexerService, txIndexer, nil
+	return indexerService, txIndexer, blockIndexer, nil
 }
 
 func doHandshake(
@@ -317,19 +356,63 @@ func onlyValidatorIsUs(state sm.State, privVal types.PrivValidator) bool {
 	return bytes.Equal(privVal.GetPubKey().Address(), addr)
 }
 
+// committedTxFunc returns the filter of the committed txs of the mempool WAL:
+// the txs in the tx index, or in the last block of blockStore, which may have
+// been committed without updating the mempool or indexing its txs.
+func committedTxFunc(txIndexer txindex.TxIndexer, blockStore *store.BlockStore) mempl.TxCommittedFunc {
+	var lastBlockTxs map[string]struct{}
+	return func(tx types.Tx) bool {
+		if lastBlockTxs == nil {
+			lastBlockTxs = make(map[string]struct{})
+			if block := blockStore.LoadBlock(blockStore.Height()); block != nil {
+				for _, blockTx := range block.Txs {
+					lastBlockTxs[string(blockTx.Hash())] = struct{}{}
+				}
+			}
+		}
+		if _, ok := lastBlockTxs[string(tx.Hash())]; ok {
+			return true
+		}
+		res, err := txIndexer.Get(tx.Hash())
+		return err == nil && res != nil
+	}
+}
+
 func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
-	state sm.State, memplMetrics *mempl.Metrics, logger log.Logger) (*mempl.Reactor, *mempl.CListMempool) {
-
-	mempool := mempl.NewCListMempool(
-		config.Mempool,
-		proxyApp.Mempool(),
-		state.LastBlockHeight,
-		mempl.WithMetrics(memplMetrics),
-		mempl.WithPreCheck(sm.TxPreCheck(state)),
-		mempl.WithPostCheck(sm.TxPostCheck(state)),
-	)
+	state sm.State, eventBus *types.EventBus, memplMetrics *mempl.Metrics,
+	txCommitted mempl.TxCommittedFunc, logger log.Logger) (*mempl.Reactor, mempl.Mempool) {
+
+	var mempoolReactor *mempl.Reactor
+	var mempool mempl.Mempool
+	switch config.Mempool.Version {
+	case "v1":
+		priorityMempool := mempl.NewPriorityMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempl.PriorityWithMetrics(memplMetrics),
+			mempl.PriorityWithEventBus(eventBus),
+			mempl.PriorityWithPreCheck(sm.TxPreCheck(state)),
+			mempl.PriorityWithPostCheck(sm.TxPostCheck(state)),
+			mempl.PriorityWithTxCommitted(txCommitted),
+		)
+		mempoolReactor = mempl.NewReactor(config.Mempool, priorityMempool)
+		mempool = priorityMempool
+	default:
+		clistMempool := mempl.NewCListMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			mempl.WithMetrics(memplMetrics),
+			mempl.WithEventBus(eventBus),
+			mempl.WithPreCheck(sm.TxPreCheck(state)),
+			mempl.WithPostCheck(sm.TxPostCheck(state)),
+			mempl.WithTxCommitted(txCommitted),
+		)
+		mempoolReactor = mempl.NewReactor(config.Mempool, clistMempool)
+		mempool = clistMempool
+	}
 	mempoolLogger := logger.With("module", "mempool")
-	mempoolReactor := mempl.NewReactor(config.Mempool, mempool)
 	mempoolReactor.SetLogger(mempoolLogger)
 
 	if config.Consensus.WaitForTxs() {
@@ -339,14 +422,14 @@ func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
 }
 
 func createEvidenceReactor(config *cfg.Config, dbProvider DBProvider,
-	stateDB dbm.DB, logger log.Logger) (*evidence.Reactor, *evidence.Pool, error) {
+	stateDB dbm.DB, blockStore *store.BlockStore, logger log.Logger) (*evidence.Reactor, *evidence.Pool, error) {
 
 	evidenceDB, err := dbProvider(&DBContext{"evidence", config})
 	if err != nil {
 		return nil, nil, err
 	}
 	evidenceLogger := logger.With("module", "evidence")
-	evidencePool := evidence.NewPool(stateDB, evidenceDB)
+	evidencePool := evidence.NewPool(stateDB, evidenceDB, blockStore)
 	evidencePool.SetLogger(evidenceLogger)
 	evidenceReactor := evidence.NewReactor(evidencePool)
 	evidenceReactor.SetLogger(evidenceLogger)
@@ -373,11 +456,64 @@ func createBlockchainReactor(config *cfg.Config,
 	return bcReactor, nil
 }
 
+// fastSyncReactor is a blockchain reactor which can start fast syncing once a state sync has
+// restored the application state.
+type fastSyncReactor interface {
+	SwitchToFastSync(sm.State) error
+}
+
+// startStateSync starts an asynchronous state sync process, then switches to fast sync mode, or
+// straight to consensus if fast sync is disabled.
+func startStateSync(ssR *statesync.Reactor, bcR fastSyncReactor, conR *cs.Reactor,
+	config *cfg.StateSyncConfig, fastSync bool, stateDB dbm.DB, blockStore *store.BlockStore,
+	state sm.State, logger log.Logger) error {
+
+	ssR.Logger.Info("Starting state sync")
+
+	go func() {
+		stateProvider, err := statesync.NewLightClientStateProvider(state.ChainID, state.Version,
+			config.RPCServers, lite.TrustOptions{
+				Period: config.TrustPeriod,
+				Height: config.TrustHeight,
+				Hash:   config.TrustHashBytes(),
+			}, logger.With("module", "lite"))
+		if err != nil {
+			ssR.Logger.Error("Failed to set up light client state provider", "err", err)
+			return
+		}
+
+		state, commit, err := ssR.Sync(stateProvider, config.DiscoveryTime)
+		if err != nil {
+			ssR.Logger.Error("State sync failed", "err", err)
+			return
+		}
+		sm.BootstrapState(stateDB, state)
+		err = blockStore.SaveSeenCommit(state.LastBlockHeight, commit)
+		if err != nil {
+			ssR.Logger.Error("Failed to store last seen commit", "err", err)
+			return
+		}
+
+		if fastSync {
+			err = bcR.SwitchToFastSync(state)
+			if err != nil {
+				ssR.Logger.Error("Failed to switch to fast sync", "err", err)
+				return
+			}
+		} else {
+			// There is nothing to replay from the consensus WAL after a state sync, so
+			// report a synced block to skip the WAL catchup.
+			conR.SwitchToConsensus(state, 1)
+		}
+	}()
+	return nil
+}
+
 func createConsensusReactor(config *cfg.Config,
 	state sm.State,
 	blockExec *sm.BlockExecutor,
 	blockStore sm.BlockStore,
-	mempool *mempl.CListMempool,
+	mempool mempl.Mempool,
 	evidencePool *evidence.Pool,
 	privValidator types.PrivValidator,
 	csMetrics *cs.Metrics,
@@ -586,7 +722,7 @@ func NewNode(config *cfg.Config,
 	}
 
 	// Transaction indexing
-	indexerService, txIndexer, err := createAndStartIndexerService(config, dbProvider, eventBus, logger)
+	indexerService, txIndexer, blockIndexer, err := createAndStartIndexerService(config, dbProvider, eventBus, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -621,17 +757,23 @@ func NewNode(config *cfg.Config,
 
 	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
 
-	// Decide whether to fast-sync or not
-	// We don't fast-sync when the only validator is us.
+	// Decide whether to state sync and/or fast sync or not.
+	// We don't sync when the only validator is us, and we only state sync from an empty state.
 	fastSync := config.FastSyncMode && !onlyValidatorIsUs(state, privValidator)
+	stateSync := config.StateSync.Enable && !onlyValidatorIsUs(state, privValidator)
+	if stateSync && state.LastBlockHeight > 0 {
+		logger.Info("Found local state with non-zero height, skipping state sync")
+		stateSync = false
+	}
 
 	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
 
 	// Make MempoolReactor
-	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
+	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, eventBus, memplMetrics,
+		committedTxFunc(txIndexer, blockStore), logger)
 
 	// Make Evidence Reactor
-	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateDB, logger)
+	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateDB, blockStore, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -646,8 +788,8 @@ func NewNode(config *cfg.Config,
 		sm.BlockExecutorWithMetrics(smMetrics),
 	)
 
-	// Make BlockchainReactor
-	bcReactor, err := createBlockchainReactor(config, state, blockExec, blockStore, fastSync, logger)
+	// Make BlockchainReactor. Don't start fast sync if we're doing a state sync first.
+	bcReactor, err := createBlockchainReactor(config, state, blockExec, blockStore, fastSync && !stateSync, logger)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create blockchain reactor")
 	}
@@ -655,9 +797,13 @@ func NewNode(config *cfg.Config,
 	// Make ConsensusReactor
 	consensusReactor, consensusState := createConsensusReactor(
 		config, state, blockExec, blockStore, mempool, evidencePool,
-		privValidator, csMetrics, fastSync, eventBus, consensusLogger,
+		privValidator, csMetrics, stateSync || fastSync, eventBus, consensusLogger,
 	)
 
+	// Make StateSyncReactor, used both to restore and to serve snapshots.
+	stateSyncReactor := statesync.NewReactor(*config.StateSync, proxyApp.Snapshot(), proxyApp.Query())
+	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
+
 	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state)
 	if err != nil {
 		return nil, err
@@ -672,6 +818,12 @@ func NewNode(config *cfg.Config,
 		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
 		consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
 	)
+	sw.AddReactor("STATESYNC", stateSyncReactor)
+
+	// Make LightBlockReactor, used to serve light clients.
+	lightBlockReactor := litep2p.NewReactor(stateDB, blockStore, evidencePool)
+	lightBlockReactor.SetLogger(logger.With("module", "lite"))
+	sw.AddReactor("LIGHTBLOCK", lightBlockReactor)
 
 	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
 	if err != nil {
@@ -725,6 +877,9 @@ func NewNode(config *cfg.Config,
 		stateDB:          stateDB,
 		blockStore:       blockStore,
 		bcReactor:        bcReactor,
+		stateSyncReactor: stateSyncReactor,
+		stateSync:        stateSync,
+		stateSyncGenesis: state, // Shouldn't be necessary, but need a way to pass the genesis state
 		mempoolReactor:   mempoolReactor,
 		mempool:          mempool,
 		consensusState:   consensusState,
@@ -733,6 +888,7 @@ func NewNode(config *cfg.Config,
 		evidencePool:     evidencePool,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
+		blockIndexer:     blockIndexer,
 		indexerService:   indexerService,
 		eventBus:         eventBus,
 	}
@@ -799,6 +955,19 @@ func (n *Node) OnStart() error {
 		return errors.Wrap(err, "could not dial peers from persistent_peers field")
 	}
 
+	// Run state sync
+	if n.stateSync {
+		bcR, ok := n.bcReactor.(fastSyncReactor)
+		if !ok {
+			return fmt.Errorf("this blockchain reactor does not support switching from state sync")
+		}
+		err := startStateSync(n.stateSyncReactor, bcR, n.consensusReactor, n.config.StateSync,
+			n.config.FastSyncMode, n.stateDB, n.blockStore, n.stateSyncGenesis, n.Logger)
+		if err != nil {
+			return errors.Wrap(err, "failed to start state sync")
+		}
+	}
+
 	return nil
 }
 
@@ -861,6 +1030,7 @@ func (n *Node) ConfigureRPC() {
 	rpccore.SetGenesisDoc(n.genesisDoc)
 	rpccore.SetProxyAppQuery(n.proxyApp.Query())
 	rpccore.SetTxIndexer(n.txIndexer)
+	rpccore.SetBlockIndexer(n.blockIndexer)
 	rpccore.SetConsensusReactor(n.consensusReactor)
 	rpccore.SetEventBus(n.eventBus)
 	rpccore.SetLogger(n.Logger.With("module", "rpc"))
@@ -1100,6 +1270,8 @@ func makeNodeInfo(
 			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel,
 			mempl.MempoolChannel,
 			evidence.EvidenceChannel,
+			litep2p.LightBlockChannel,
+			statesync.SnapshotChannel, statesync.ChunkChannel,
 		},
 		Moniker: config.Moniker,
 		Other: p2p.DefaultNodeInfoOther{
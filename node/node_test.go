@@ -13,8 +13,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/abci/example/kvstore"
+	abci "github.com/tendermint/tendermint/abci/types"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/evidence"
 	"github.com/tendermint/tendermint/libs/log"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
@@ -24,6 +26,8 @@ import (
 	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/txindex/kv"
+	"github.com/tendermint/tendermint/state/txindex/null"
 	"github.com/tendermint/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 	"github.com/tendermint/tendermint/version"
@@ -128,6 +132,38 @@ func TestNodeSetAppVersion(t *testing.T) {
 	assert.Equal(t, n.nodeInfo.(p2p.DefaultNodeInfo).ProtocolVersion.App, appVersion)
 }
 
+func TestNodeMempoolVersion(t *testing.T) {
+	config := cfg.ResetTestRoot("node_mempool_version_test")
+	defer os.RemoveAll(config.RootDir)
+
+	// the clist mempool is the default
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &mempl.CListMempool{}, n.Mempool())
+
+	config.Mempool.Version = "v1"
+	n, err = DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &mempl.PriorityMempool{}, n.Mempool())
+}
+
+func TestNodeTxIndexers(t *testing.T) {
+	config := cfg.ResetTestRoot("node_tx_indexers_test")
+	defer os.RemoveAll(config.RootDir)
+
+	// the kv indexer is the default
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &kv.TxIndex{}, n.txIndexer)
+	assert.IsType(t, &kv.BlockIndex{}, n.blockIndexer)
+
+	config.TxIndex.Indexer = "null"
+	n, err = DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &null.TxIndex{}, n.txIndexer)
+	assert.IsType(t, &null.BlockIndex{}, n.blockIndexer)
+}
+
 func TestNodeSetPrivValTCP(t *testing.T) {
 	addr := "tcp://" + testFreeAddr(t)
 
@@ -249,7 +285,7 @@ func TestCreateProposalBlock(t *testing.T) {
 	types.RegisterMockEvidencesGlobal() // XXX!
 	evidence.RegisterMockEvidences()
 	evidenceDB := dbm.NewMemDB()
-	evidencePool := evidence.NewPool(stateDB, evidenceDB)
+	evidencePool := evidence.NewPool(stateDB, evidenceDB, nil)
 	evidencePool.SetLogger(logger)
 
 	// fill the evidence pool with more evidence
@@ -323,6 +359,125 @@ func TestNodeNewNodeCustomReactors(t *testing.T) {
 	assert.Equal(t, customBlockchainReactor, n.Switch().Reactor("BLOCKCHAIN"))
 }
 
+func TestNodeStateSyncSnapshot(t *testing.T) {
+	configA := cfg.ResetTestRoot("node_state_sync_snapshot_test_a")
+	defer os.RemoveAll(configA.RootDir)
+	configB := cfg.ResetTestRoot("node_state_sync_snapshot_test_b")
+	defer os.RemoveAll(configB.RootDir)
+
+	newSnapshotNode := func(config *cfg.Config, app abci.Application) *Node {
+		nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+		require.NoError(t, err)
+		n, err := NewNode(config,
+			privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()),
+			nodeKey,
+			proxy.NewLocalClientCreator(app),
+			DefaultGenesisDocProviderFunc(config),
+			DefaultDBProvider,
+			DefaultMetricsProvider(config.Instrumentation),
+			log.TestingLogger(),
+		)
+		require.NoError(t, err)
+		return n
+	}
+
+	// a serves the snapshot
+	nodeA := newSnapshotNode(configA, &snapshotApp{})
+	err := nodeA.Start()
+	require.NoError(t, err)
+	defer nodeA.Stop()
+
+	// b is not a validator, and restores the snapshot from a
+	err = os.Remove(configB.PrivValidatorKeyFile())
+	require.NoError(t, err)
+	configB.P2P.ListenAddress = "tcp://127.0.0.1:36666"
+	configB.P2P.PersistentPeers = p2p.IDAddressString(nodeA.NodeInfo().ID(), "127.0.0.1:36656")
+	configB.RPC.ListenAddress = "tcp://127.0.0.1:36667"
+	configB.RPC.GRPCListenAddress = ""
+	appB := &snapshotApp{}
+	nodeB := newSnapshotNode(configB, appB)
+	err = nodeB.Start()
+	require.NoError(t, err)
+	defer nodeB.Stop()
+
+	require.Eventually(t, func() bool { return nodeB.Switch().Peers().Size() == 1 },
+		10*time.Second, 100*time.Millisecond)
+
+	// the snapshot and its chunk are sent on the state sync channels, which
+	// are dropped unless the peers advertise them
+	synced := make(chan error, 1)
+	var (
+		state  sm.State
+		commit *types.Commit
+	)
+	go func() {
+		var err error
+		state, commit, err = nodeB.stateSyncReactor.Sync(testStateProvider{}, time.Second)
+		synced <- err
+	}()
+	select {
+	case err := <-synced:
+		require.NoError(t, err)
+	case <-time.After(30 * time.Second):
+		t.Fatal("timed out waiting for the snapshot")
+	}
+	assert.EqualValues(t, snapshotHeight, state.LastBlockHeight)
+	assert.EqualValues(t, snapshotHeight, commit.Height)
+	assert.Equal(t, snapshotChunk, appB.restored)
+}
+
+const snapshotHeight = 1
+
+var snapshotChunk = []byte("snapshot chunk")
+
+// snapshotApp serves a snapshot of a single chunk, and restores it.
+type snapshotApp struct {
+	abci.BaseApplication
+
+	restored []byte
+}
+
+func (app *snapshotApp) Info(req abci.RequestInfo) abci.ResponseInfo {
+	if app.restored == nil {
+		return abci.ResponseInfo{}
+	}
+	return abci.ResponseInfo{LastBlockHeight: snapshotHeight, LastBlockAppHash: tmhash.Sum(app.restored)}
+}
+
+func (app *snapshotApp) ListSnapshots(req abci.RequestListSnapshots) abci.ResponseListSnapshots {
+	return abci.ResponseListSnapshots{Snapshots: []*abci.Snapshot{
+		{Height: snapshotHeight, Format: 1, Chunks: 1, Hash: tmhash.Sum(snapshotChunk)},
+	}}
+}
+
+func (app *snapshotApp) LoadSnapshotChunk(req abci.RequestLoadSnapshotChunk) abci.ResponseLoadSnapshotChunk {
+	return abci.ResponseLoadSnapshotChunk{Chunk: snapshotChunk}
+}
+
+func (app *snapshotApp) OfferSnapshot(req abci.RequestOfferSnapshot) abci.ResponseOfferSnapshot {
+	return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}
+}
+
+func (app *snapshotApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.ResponseApplySnapshotChunk {
+	app.restored = req.Chunk
+	return abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT}
+}
+
+// testStateProvider trusts the snapshot of the snapshotApp.
+type testStateProvider struct{}
+
+func (testStateProvider) AppHash(height uint64) ([]byte, error) {
+	return tmhash.Sum(snapshotChunk), nil
+}
+
+func (testStateProvider) Commit(height uint64) (*types.Commit, error) {
+	return &types.Commit{Height: int64(height)}, nil
+}
+
+func (testStateProvider) State(height uint64) (sm.State, error) {
+	return sm.State{LastBlockHeight: int64(height)}, nil
+}
+
 func state(nVals int, height int64) (sm.State, dbm.DB) {
 	vals := make([]types.GenesisValidator, nVals)
 	for i := 0; i < nVals; i++ {
@@ -0,0 +1,10 @@
+// +build sqlite
+
+package node
+
+import (
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver of the sql indexer
+)
+
+// The sqlite3 driver requires cgo, so it's only linked when building with the
+// "sqlite" tag (e.g. make build_c). Other builds support postgres only.
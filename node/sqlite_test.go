@@ -0,0 +1,28 @@
+// +build sqlite
+
+package node
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/state/txindex"
+)
+
+func TestNodeSQLTxIndexer(t *testing.T) {
+	config := cfg.ResetTestRoot("node_sql_tx_indexer_test")
+	defer os.RemoveAll(config.RootDir)
+
+	config.TxIndex.Indexer = "kv,sql"
+	config.TxIndex.SQLConn = filepath.Join(config.RootDir, "tx_index.sqlite")
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.IsType(t, &txindex.MultiTxIndex{}, n.txIndexer)
+	assert.IsType(t, &txindex.MultiBlockIndex{}, n.blockIndexer)
+}
@@ -348,13 +348,20 @@ func (c *baseRPCClient) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return result, nil
 }
 
-func (c *baseRPCClient) TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
+func (c *baseRPCClient) TxSearch(
+	query string,
+	prove bool,
+	page, perPage int,
+	orderBy, cursor string,
+) (*ctypes.ResultTxSearch, error) {
 	result := new(ctypes.ResultTxSearch)
 	params := map[string]interface{}{
 		"query":    query,
 		"prove":    prove,
 		"page":     page,
 		"per_page": perPage,
+		"order_by": orderBy,
+		"cursor":   cursor,
 	}
 	_, err := c.caller.Call("tx_search", params, result)
 	if err != nil {
@@ -363,6 +370,20 @@ func (c *baseRPCClient) TxSearch(query string, prove bool, page, perPage int) (*
 	return result, nil
 }
 
+func (c *baseRPCClient) BlockSearch(query string, page, perPage int) (*ctypes.ResultBlockSearch, error) {
+	result := new(ctypes.ResultBlockSearch)
+	params := map[string]interface{}{
+		"query":    query,
+		"page":     page,
+		"per_page": perPage,
+	}
+	_, err := c.caller.Call("block_search", params, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "BlockSearch")
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Validators(height *int64, page, perPage int) (*ctypes.ResultValidators, error) {
 	result := new(ctypes.ResultValidators)
 	_, err := c.caller.Call("validators", map[string]interface{}{
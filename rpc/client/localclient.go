@@ -10,7 +10,6 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
-	nm "github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/rpc/core"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
@@ -43,13 +42,21 @@ type Local struct {
 	ctx    *rpctypes.Context
 }
 
+// NodeService describes the parts of a Node used by the Local client. It is
+// satisfied by *node.Node, and avoids an import cycle between the rpc client
+// and the node.
+type NodeService interface {
+	ConfigureRPC()
+	EventBus() *types.EventBus
+}
+
 // NewLocal configures a client that calls the Node directly.
 //
 // Note that given how rpc/core works with package singletons, that
 // you can only have one node per process.  So make sure test cases
 // don't run in parallel, or try to simulate an entire network in
 // one process...
-func NewLocal(node *nm.Node) *Local {
+func NewLocal(node NodeService) *Local {
 	node.ConfigureRPC()
 	return &Local{
 		EventBus: node.EventBus(),
@@ -160,8 +167,17 @@ func (c *Local) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return core.Tx(c.ctx, hash, prove)
 }
 
-func (c *Local) TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
-	return core.TxSearch(c.ctx, query, prove, page, perPage)
+func (c *Local) TxSearch(
+	query string,
+	prove bool,
+	page, perPage int,
+	orderBy, cursor string,
+) (*ctypes.ResultTxSearch, error) {
+	return core.TxSearch(c.ctx, query, prove, page, perPage, orderBy, cursor)
+}
+
+func (c *Local) BlockSearch(query string, page, perPage int) (*ctypes.ResultBlockSearch, error) {
+	return core.BlockSearch(c.ctx, query, page, perPage)
 }
 
 func (c *Local) BroadcastEvidence(ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
@@ -430,7 +430,7 @@ func TestTxSearch(t *testing.T) {
 
 		// now we query for the tx.
 		// since there's only one tx, we know index=0.
-		result, err := c.TxSearch(fmt.Sprintf("tx.hash='%v'", txHash), true, 1, 30)
+		result, err := c.TxSearch(fmt.Sprintf("tx.hash='%v'", txHash), true, 1, 30, "", "")
 		require.Nil(t, err, "%+v", err)
 		require.Len(t, result.Txs, 1)
 
@@ -448,33 +448,98 @@ func TestTxSearch(t *testing.T) {
 		}
 
 		// query by height
-		result, err = c.TxSearch(fmt.Sprintf("tx.height=%d", txHeight), true, 1, 30)
+		result, err = c.TxSearch(fmt.Sprintf("tx.height=%d", txHeight), true, 1, 30, "", "")
 		require.Nil(t, err, "%+v", err)
 		require.Len(t, result.Txs, 1)
 
 		// query for non existing tx
-		result, err = c.TxSearch(fmt.Sprintf("tx.hash='%X'", anotherTxHash), false, 1, 30)
+		result, err = c.TxSearch(fmt.Sprintf("tx.hash='%X'", anotherTxHash), false, 1, 30, "", "")
 		require.Nil(t, err, "%+v", err)
 		require.Len(t, result.Txs, 0)
 
 		// query using a compositeKey (see kvstore application)
-		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko'", false, 1, 30)
+		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko'", false, 1, 30, "", "")
 		require.Nil(t, err, "%+v", err)
 		if len(result.Txs) == 0 {
 			t.Fatal("expected a lot of transactions")
 		}
 
 		// query using a compositeKey (see kvstore application) and height
-		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko' AND tx.height<10000", true, 1, 30)
+		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko' AND tx.height<10000", true, 1, 30, "", "")
 		require.Nil(t, err, "%+v", err)
 		if len(result.Txs) == 0 {
 			t.Fatal("expected a lot of transactions")
 		}
 
 		// query a non existing tx with page 1 and txsPerPage 1
-		result, err = c.TxSearch("app.creator='Cosmoshi Neetowoko'", true, 1, 1)
+		result, err = c.TxSearch("app.creator='Cosmoshi Neetowoko'", true, 1, 1, "", "")
 		require.Nil(t, err, "%+v", err)
 		require.Len(t, result.Txs, 0)
+
+		// check sorting
+		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko'", false, 1, 30, "asc", "")
+		require.Nil(t, err, "%+v", err)
+		for k := 0; k < len(result.Txs)-1; k++ {
+			require.LessOrEqual(t, result.Txs[k].Height, result.Txs[k+1].Height)
+		}
+
+		result, err = c.TxSearch("app.creator='Cosmoshi Netowoko'", false, 1, 30, "desc", "")
+		require.Nil(t, err, "%+v", err)
+		for k := 0; k < len(result.Txs)-1; k++ {
+			require.GreaterOrEqual(t, result.Txs[k].Height, result.Txs[k+1].Height)
+		}
+
+		// iterate over the pages with cursors
+		var (
+			cursor string
+			seen   = make(map[string]bool)
+		)
+		for {
+			result, err = c.TxSearch("app.creator='Cosmoshi Netowoko'", false, 0, 2, "asc", cursor)
+			require.Nil(t, err, "%+v", err)
+			for _, tx := range result.Txs {
+				seen[tx.Hash.String()] = true
+			}
+			if result.NextCursor == "" {
+				break
+			}
+			cursor = result.NextCursor
+		}
+		require.Len(t, seen, result.TotalCount)
+	}
+}
+
+func TestBlockSearch(t *testing.T) {
+	c := getHTTPClient()
+
+	// first we broadcast a few txs, so we have some blocks
+	for i := 0; i < 3; i++ {
+		_, _, tx := MakeTxKV()
+		_, err := c.BroadcastTxCommit(tx)
+		require.NoError(t, err)
+	}
+
+	for i, c := range GetClients() {
+		t.Logf("client %d", i)
+
+		// query by height
+		result, err := c.BlockSearch("block.height = 1", 1, 30)
+		require.NoError(t, err)
+		require.Len(t, result.Blocks, 1)
+		assert.EqualValues(t, 1, result.Blocks[0].Block.Height)
+		assert.Equal(t, 1, result.TotalCount)
+
+		// query by height range, paginated
+		result, err = c.BlockSearch("block.height >= 1 AND block.height <= 3", 2, 2)
+		require.NoError(t, err)
+		require.Len(t, result.Blocks, 1)
+		assert.EqualValues(t, 3, result.Blocks[0].Block.Height)
+		assert.Equal(t, 3, result.TotalCount)
+
+		// query for a non existing event
+		result, err = c.BlockSearch("app.creator = 'Cosmoshi Neetowoko'", 1, 30)
+		require.NoError(t, err)
+		require.Len(t, result.Blocks, 0)
 	}
 }
 
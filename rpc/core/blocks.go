@@ -4,20 +4,23 @@ import (
 	"fmt"
 
 	tmmath "github.com/tendermint/tendermint/libs/math"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
 	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/state/txindex/null"
 	"github.com/tendermint/tendermint/types"
 )
 
 // BlockchainInfo gets block headers for minHeight <= height <= maxHeight.
-// Block headers are returned in descending order (highest first).
+// Block headers are returned in descending order (highest first). The heights
+// below the base of the block store were pruned and are left out.
 // More: https://docs.tendermint.com/master/rpc/#/Info/blockchain
 func BlockchainInfo(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultBlockchainInfo, error) {
 	// maximum 20 block metas
 	const limit int64 = 20
 	var err error
-	minHeight, maxHeight, err = filterMinMax(blockStore.Height(), minHeight, maxHeight, limit)
+	minHeight, maxHeight, err = filterMinMax(blockStore.Base(), blockStore.Height(), minHeight, maxHeight, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -34,11 +37,12 @@ func BlockchainInfo(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.
 		BlockMetas: blockMetas}, nil
 }
 
-// error if either min or max are negative or min < max
+// error if either min or max are negative or min > max
 // if 0, use 1 for min, latest block height for max
+// limit min to the base of the block store
 // enforce limit.
 // error if min > max
-func filterMinMax(height, min, max, limit int64) (int64, int64, error) {
+func filterMinMax(base, height, min, max, limit int64) (int64, int64, error) {
 	// filter negatives
 	if min < 0 || max < 0 {
 		return min, max, fmt.Errorf("heights must be non-negative")
@@ -55,6 +59,9 @@ func filterMinMax(height, min, max, limit int64) (int64, int64, error) {
 	// limit max to the height
 	max = tmmath.MinInt64(height, max)
 
+	// limit min to the base
+	min = tmmath.MaxInt64(base, min)
+
 	// limit min to within `limit` of max
 	// so the total number of blocks returned will be `limit`
 	min = tmmath.MaxInt64(min, max-limit+1)
@@ -69,8 +76,7 @@ func filterMinMax(height, min, max, limit int64) (int64, int64, error) {
 // If no height is provided, it will fetch the latest block.
 // More: https://docs.tendermint.com/master/rpc/#/Info/block
 func Block(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlock, error) {
-	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getHeight(blockStore.Base(), blockStore.Height(), heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +106,7 @@ func BlockByHash(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultBlock, error
 // More: https://docs.tendermint.com/master/rpc/#/Info/commit
 func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, error) {
 	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getHeight(blockStore.Base(), storeHeight, heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -131,8 +137,7 @@ func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, erro
 // getBlock(h).Txs[5]
 // More: https://docs.tendermint.com/master/rpc/#/Info/block_results
 func BlockResults(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockResults, error) {
-	storeHeight := blockStore.Height()
-	height, err := getHeight(storeHeight, heightPtr)
+	height, err := getHeight(blockStore.Base(), blockStore.Height(), heightPtr)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +157,63 @@ func BlockResults(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockR
 	}, nil
 }
 
-func getHeight(currentHeight int64, heightPtr *int64) (int64, error) {
+// BlockSearch allows you to query for blocks by the events returned from
+// BeginBlock and EndBlock, using the same query syntax as tx_search. It returns
+// a list of blocks (maximum ?per_page entries) in ascending order of height,
+// and the total count. Blocks below the base of the block store were pruned
+// and are left out.
+// More: https://docs.tendermint.com/master/rpc/#/Info/block_search
+func BlockSearch(ctx *rpctypes.Context, query string, page, perPage int) (*ctypes.ResultBlockSearch, error) {
+	// if index is disabled, return error
+	if _, ok := blockIndexer.(*null.BlockIndex); ok {
+		return nil, fmt.Errorf("block indexing is disabled")
+	}
+
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := blockIndexer.Search(q)
+	if err != nil {
+		return nil, err
+	}
+
+	base := blockStore.Base()
+	heights := make([]int64, 0, len(results))
+	for _, height := range results {
+		if height >= base {
+			heights = append(heights, height)
+		}
+	}
+
+	totalCount := len(heights)
+	perPage = validatePerPage(perPage)
+	page, err = validatePage(page, perPage, totalCount)
+	if err != nil {
+		return nil, err
+	}
+	skipCount := validateSkipCount(page, perPage)
+
+	apiResults := make([]*ctypes.ResultBlock, tmmath.MinInt(perPage, totalCount-skipCount))
+	for i := 0; i < len(apiResults); i++ {
+		height := heights[skipCount+i]
+		block := blockStore.LoadBlock(height)
+		blockMeta := blockStore.LoadBlockMeta(height)
+		if blockMeta == nil {
+			apiResults[i] = &ctypes.ResultBlock{BlockID: types.BlockID{}, Block: block}
+			continue
+		}
+		apiResults[i] = &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: block}
+	}
+
+	return &ctypes.ResultBlockSearch{Blocks: apiResults, TotalCount: totalCount}, nil
+}
+
+// getHeight returns the height pointed by heightPtr, or currentHeight if nil.
+// It returns an error if the height is above currentHeight, or below
+// currentBase since its block was pruned.
+func getHeight(currentBase int64, currentHeight int64, heightPtr *int64) (int64, error) {
 	if heightPtr != nil {
 		height := *heightPtr
 		if height <= 0 {
@@ -161,6 +222,10 @@ func getHeight(currentHeight int64, heightPtr *int64) (int64, error) {
 		if height > currentHeight {
 			return 0, fmt.Errorf("height must be less than or equal to the current blockchain height")
 		}
+		if height < currentBase {
+			return 0, fmt.Errorf("height %v is not available, blocks pruned at height %v",
+				height, currentBase)
+		}
 		return height, nil
 	}
 	return currentHeight, nil
@@ -70,6 +70,7 @@ var (
 	pubKey           crypto.PubKey
 	genDoc           *types.GenesisDoc // cache the genesis structure
 	txIndexer        txindex.TxIndexer
+	blockIndexer     txindex.BlockIndexer
 	consensusReactor *consensus.Reactor
 	eventBus         *types.EventBus // thread safe
 	mempool          mempl.Mempool
@@ -123,6 +124,10 @@ func SetTxIndexer(indexer txindex.TxIndexer) {
 	txIndexer = indexer
 }
 
+func SetBlockIndexer(indexer txindex.BlockIndexer) {
+	blockIndexer = indexer
+}
+
 func SetConsensusReactor(conR *consensus.Reactor) {
 	consensusReactor = conR
 }
@@ -2,12 +2,14 @@ package core
 
 import (
 	"fmt"
+	"math"
 
 	tmmath "github.com/tendermint/tendermint/libs/math"
 
 	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/null"
 	"github.com/tendermint/tendermint/types"
 )
@@ -51,9 +53,17 @@ func Tx(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error
 }
 
 // TxSearch allows you to query for multiple transactions results. It returns a
-// list of transactions (maximum ?per_page entries) and the total count.
+// list of transactions (maximum ?per_page entries), ordered by height & index
+// (?order_by "asc" or "desc"), and the total count. Instead of ?page, the
+// next_cursor of a previous search can be given as ?cursor to continue it.
 // More: https://docs.tendermint.com/master/rpc/#/Info/tx_search
-func TxSearch(ctx *rpctypes.Context, query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
+func TxSearch(
+	ctx *rpctypes.Context,
+	query string,
+	prove bool,
+	page, perPage int,
+	orderBy, cursor string,
+) (*ctypes.ResultTxSearch, error) {
 	// if index is disabled, return error
 	if _, ok := txIndexer.(*null.TxIndex); ok {
 		return nil, fmt.Errorf("transaction indexing is disabled")
@@ -64,24 +74,37 @@ func TxSearch(ctx *rpctypes.Context, query string, prove bool, page, perPage int
 		return nil, err
 	}
 
-	results, err := txIndexer.Search(q)
-	if err != nil {
-		return nil, err
+	perPage = validatePerPage(perPage)
+	opts := txindex.SearchOptions{OrderBy: orderBy, Cursor: cursor, Limit: perPage}
+	if cursor != "" && page > 1 {
+		return nil, fmt.Errorf("page and cursor can't be combined")
+	} else if cursor == "" && page > 1 {
+		// fetch all the txs up to the requested page, which is validated against
+		// the total count below
+		if page > math.MaxInt32/perPage {
+			return nil, fmt.Errorf("page should be within [0, %d] range, given %d", math.MaxInt32/perPage, page)
+		}
+		opts.Limit = page * perPage
 	}
 
-	totalCount := len(results)
-	perPage = validatePerPage(perPage)
-	page, err = validatePage(page, perPage, totalCount)
+	res, err := txIndexer.SearchPage(q, opts)
 	if err != nil {
 		return nil, err
 	}
-	skipCount := validateSkipCount(page, perPage)
 
-	apiResults := make([]*ctypes.ResultTx, tmmath.MinInt(perPage, totalCount-skipCount))
+	skipCount := 0
+	if cursor == "" {
+		page, err = validatePage(page, perPage, res.TotalCount)
+		if err != nil {
+			return nil, err
+		}
+		skipCount = validateSkipCount(page, perPage)
+	}
+	results := res.Txs[tmmath.MinInt(skipCount, len(res.Txs)):]
+
+	apiResults := make([]*ctypes.ResultTx, len(results))
 	var proof types.TxProof
-	// if there's no tx in the results array, we don't need to loop through the apiResults array
-	for i := 0; i < len(apiResults); i++ {
-		r := results[skipCount+i]
+	for i, r := range results {
 		height := r.Height
 		index := r.Index
 
@@ -100,5 +123,5 @@ func TxSearch(ctx *rpctypes.Context, query string, prove bool, page, perPage int
 		}
 	}
 
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: res.TotalCount, NextCursor: res.NextCursor}, nil
 }
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	txkv "github.com/tendermint/tendermint/state/txindex/kv"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestTxSearchPage(t *testing.T) {
+	txIndexer = txkv.NewTxIndex(dbm.NewMemDB(), txkv.IndexAllEvents())
+	for i := uint32(0); i < 3; i++ {
+		err := txIndexer.Index(&types.TxResult{
+			Height: 1,
+			Index:  i,
+			Tx:     types.Tx(fmt.Sprintf("tx %d", i)),
+			Result: abci.ResponseDeliverTx{
+				Events: []abci.Event{{Type: "account", Attributes: []kv.Pair{{Key: []byte("number"), Value: []byte("1")}}}},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		page, perPage int
+		wantErr       bool
+		wantIndexes   []uint32
+	}{
+		{0, 2, false, []uint32{0, 1}},
+		{2, 2, false, []uint32{2}},
+		{3, 2, true, nil},
+		{-1, 2, true, nil},
+		{int(^uint(0) >> 1), 2, true, nil},
+		{math.MaxInt32, 100, true, nil},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("page %d per page %d", tc.page, tc.perPage), func(t *testing.T) {
+			res, err := TxSearch(&rpctypes.Context{}, "account.number = 1", false, tc.page, tc.perPage, "", "")
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 3, res.TotalCount)
+			indexes := make([]uint32, len(res.Txs))
+			for i, tx := range res.Txs {
+				indexes[i] = tx.Index
+			}
+			assert.Equal(t, tc.wantIndexes, indexes)
+		})
+	}
+}
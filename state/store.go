@@ -113,6 +113,111 @@ func saveState(db dbm.DB, state State, key []byte) {
 	db.SetSync(key, state.Bytes())
 }
 
+// BootstrapState saves a new state, used e.g. by state sync when starting from
+// a non-zero height. Unlike SaveState, the full validator sets of the last,
+// current and next heights and the consensus params of the next height are
+// saved, since there are no previous heights to load them from.
+func BootstrapState(db dbm.DB, state State) {
+	height := state.LastBlockHeight + 1
+	if height > 1 && state.LastValidators != nil && state.LastValidators.Size() > 0 {
+		saveValidatorsInfo(db, height-1, height-1, state.LastValidators)
+	}
+	saveValidatorsInfo(db, height, height, state.Validators)
+	saveValidatorsInfo(db, height+1, height+1, state.NextValidators)
+	saveConsensusParamsInfo(db, height, height, state.ConsensusParams)
+	db.SetSync(stateKey, state.Bytes())
+}
+
+// PruneStates deletes the ABCIResponses, validator sets and consensus params
+// saved for the heights from from (included) to to (excluded). The validator
+// set and the consensus params at to must exist, and the heights they were
+// last changed at, as well as the last validator set checkpoint, are kept so
+// that they can still be loaded.
+//
+// The from height is necessary since the keys are not ordered by height, and
+// the states below it which are kept by previous prunes are left behind.
+func PruneStates(db dbm.DB, from int64, to int64) error {
+	if from <= 0 || to <= 0 {
+		return fmt.Errorf("from height %v and to height %v must be greater than 0", from, to)
+	}
+	if from >= to {
+		return fmt.Errorf("from height %v must be lower than to height %v", from, to)
+	}
+	valInfo := loadValidatorsInfo(db, to)
+	if valInfo == nil {
+		return fmt.Errorf("validators at height %v not found", to)
+	}
+	paramsInfo := loadConsensusParamsInfo(db, to)
+	if paramsInfo == nil {
+		return fmt.Errorf("consensus params at height %v not found", to)
+	}
+
+	keepVals := make(map[int64]bool)
+	if valInfo.ValidatorSet == nil {
+		keepVals[valInfo.LastHeightChanged] = true
+		keepVals[lastStoredHeightFor(to, valInfo.LastHeightChanged)] = true // keep last checkpoint too
+	}
+	keepParams := make(map[int64]bool)
+	if paramsInfo.ConsensusParams.Equals(&types.ConsensusParams{}) {
+		keepParams[paramsInfo.LastHeightChanged] = true
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+	pruned := uint64(0)
+	var err error
+
+	// We have to delete in reverse order, to avoid deleting previous heights
+	// that have validator sets and consensus params that we may need to load.
+	for h := to - 1; h >= from; h-- {
+		// The kept heights must hold the full validator set and consensus
+		// params, since they may be loaded directly as well as through a
+		// LastHeightChanged.
+		if keepVals[h] {
+			v := loadValidatorsInfo(db, h)
+			if v.ValidatorSet == nil {
+				v.ValidatorSet, err = LoadValidators(db, h)
+				if err != nil {
+					return err
+				}
+				v.LastHeightChanged = h
+				batch.Set(calcValidatorsKey(h), v.Bytes())
+			}
+		} else {
+			batch.Delete(calcValidatorsKey(h))
+		}
+
+		if keepParams[h] {
+			p := loadConsensusParamsInfo(db, h)
+			if p.ConsensusParams.Equals(&types.ConsensusParams{}) {
+				p.ConsensusParams, err = LoadConsensusParams(db, h)
+				if err != nil {
+					return err
+				}
+				p.LastHeightChanged = h
+				batch.Set(calcConsensusParamsKey(h), p.Bytes())
+			}
+		} else {
+			batch.Delete(calcConsensusParamsKey(h))
+		}
+
+		batch.Delete(calcABCIResponsesKey(h))
+		pruned++
+
+		// flush every 1000 heights to avoid batches becoming too large
+		if pruned%1000 == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = db.NewBatch()
+			defer batch.Close()
+		}
+	}
+
+	return batch.WriteSync()
+}
+
 //------------------------------------------------------------------------
 
 // ABCIResponses retains the responses
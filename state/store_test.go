@@ -8,7 +8,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -35,6 +37,159 @@ func TestStoreLoadValidators(t *testing.T) {
 	assert.NotZero(t, loadedVals.Size())
 }
 
+func TestBootstrapState(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	lastVal, _ := types.RandValidator(true, 10)
+	val, _ := types.RandValidator(true, 10)
+	nextVal, _ := types.RandValidator(true, 10)
+	params := types.DefaultConsensusParams()
+	params.Block.MaxBytes = 1000
+
+	state := sm.State{
+		ChainID:                          "test-chain",
+		LastBlockHeight:                  100,
+		LastValidators:                   types.NewValidatorSet([]*types.Validator{lastVal}),
+		Validators:                       types.NewValidatorSet([]*types.Validator{val}),
+		NextValidators:                   types.NewValidatorSet([]*types.Validator{nextVal}),
+		LastHeightValidatorsChanged:      102,
+		ConsensusParams:                  *params,
+		LastHeightConsensusParamsChanged: 101,
+		AppHash:                          []byte("app_hash"),
+	}
+	sm.BootstrapState(stateDB, state)
+
+	loaded := sm.LoadState(stateDB)
+	assert.Equal(t, state.Bytes(), loaded.Bytes())
+
+	// The validator sets and consensus params must be loadable without any previous heights.
+	for height, expect := range map[int64]*types.ValidatorSet{
+		100: state.LastValidators,
+		101: state.Validators,
+		102: state.NextValidators,
+	} {
+		vals, err := sm.LoadValidators(stateDB, height)
+		require.NoError(t, err)
+		assert.Equal(t, expect.Hash(), vals.Hash(), "height %v", height)
+	}
+	loadedParams, err := sm.LoadConsensusParams(stateDB, 101)
+	require.NoError(t, err)
+	assert.Equal(t, state.ConsensusParams, loadedParams)
+}
+
+func TestPruneStates(t *testing.T) {
+	testcases := map[string]struct {
+		makeHeights  int64
+		pruneFrom    int64
+		pruneTo      int64
+		expectErr    bool
+		expectVals   []int64
+		expectParams []int64
+		expectABCI   []int64
+	}{
+		"error on pruning from 0":      {100, 0, 5, true, nil, nil, nil},
+		"error when from > to":         {100, 3, 2, true, nil, nil, nil},
+		"error when from == to":        {100, 3, 3, true, nil, nil, nil},
+		"error when to does not exist": {100, 1, 101, true, nil, nil, nil},
+		"prune all":                    {100, 1, 100, false, []int64{93, 100}, []int64{95, 100}, []int64{100}},
+		"prune some": {10, 2, 8, false, []int64{1, 3, 8, 9, 10},
+			[]int64{1, 5, 8, 9, 10}, []int64{1, 8, 9, 10}},
+		"prune across checkpoint": {100001, 1, 100001, false, []int64{99993, 100000, 100001},
+			[]int64{99995, 100001}, []int64{100001}},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			pk := ed25519.GenPrivKey().PubKey()
+
+			// Generate a bunch of state data. Validators change for heights ending with 3, and
+			// parameters when ending with 5.
+			validator := &types.Validator{Address: []byte{1, 2, 3}, VotingPower: 100, PubKey: pk}
+			validatorSet := &types.ValidatorSet{
+				Validators: []*types.Validator{validator},
+				Proposer:   validator,
+			}
+			valsChanged := int64(0)
+			paramsChanged := int64(0)
+
+			for h := int64(1); h <= tc.makeHeights; h++ {
+				if valsChanged == 0 || h%10 == 2 {
+					valsChanged = h + 1 // Have to add 1, since NextValidators is what's stored
+				}
+				if paramsChanged == 0 || h%10 == 5 {
+					paramsChanged = h
+				}
+
+				sm.SaveState(db, sm.State{
+					LastBlockHeight: h - 1,
+					Validators:      validatorSet,
+					NextValidators:  validatorSet,
+					ConsensusParams: types.ConsensusParams{
+						Block: types.BlockParams{MaxBytes: 10e6},
+					},
+					LastHeightValidatorsChanged:      valsChanged,
+					LastHeightConsensusParamsChanged: paramsChanged,
+				})
+				sm.SaveABCIResponses(db, h, &sm.ABCIResponses{
+					DeliverTxs: []*abci.ResponseDeliverTx{
+						{Data: []byte{1}},
+						{Data: []byte{2}},
+						{Data: []byte{3}},
+					},
+				})
+			}
+
+			// Test assertions
+			err := sm.PruneStates(db, tc.pruneFrom, tc.pruneTo)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			expectVals := sliceToMap(tc.expectVals)
+			expectParams := sliceToMap(tc.expectParams)
+			expectABCI := sliceToMap(tc.expectABCI)
+
+			for h := int64(1); h <= tc.makeHeights; h++ {
+				vals, err := sm.LoadValidators(db, h)
+				if expectVals[h] {
+					require.NoError(t, err, "validators height %v", h)
+					require.NotNil(t, vals)
+				} else {
+					require.Error(t, err, "validators height %v", h)
+					require.Equal(t, sm.ErrNoValSetForHeight{Height: h}, err)
+				}
+
+				params, err := sm.LoadConsensusParams(db, h)
+				if expectParams[h] {
+					require.NoError(t, err, "params height %v", h)
+					require.False(t, params.Equals(&types.ConsensusParams{}))
+				} else {
+					require.Error(t, err, "params height %v", h)
+				}
+
+				abciRes, err := sm.LoadABCIResponses(db, h)
+				if expectABCI[h] {
+					require.NoError(t, err, "abci height %v", h)
+					require.NotNil(t, abciRes)
+				} else {
+					require.Error(t, err, "abci height %v", h)
+					require.Equal(t, sm.ErrNoABCIResponsesForHeight{Height: h}, err)
+				}
+			}
+		})
+	}
+}
+
+func sliceToMap(s []int64) map[int64]bool {
+	m := make(map[int64]bool, len(s))
+	for _, i := range s {
+		m[i] = true
+	}
+	return m
+}
+
 func BenchmarkLoadValidators(b *testing.B) {
 	const valSetSize = 100
 
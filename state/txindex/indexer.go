@@ -22,6 +22,27 @@ type TxIndexer interface {
 
 	// Search allows you to query for transactions.
 	Search(q *query.Query) ([]*types.TxResult, error)
+
+	// SearchPage allows you to query for a single page of transactions,
+	// ordered by height & index. It also returns the total number of matching
+	// transactions, and a cursor to continue the search.
+	SearchPage(q *query.Query, opts SearchOptions) (*SearchResult, error)
+}
+
+// BlockIndexer interface defines methods to index and search blocks by the
+// events returned from BeginBlock and EndBlock.
+type BlockIndexer interface {
+
+	// Has returns true if the block at the given height has been indexed.
+	Has(height int64) (bool, error)
+
+	// Index analyzes, indexes and stores the BeginBlock and EndBlock events of a
+	// block by its height.
+	Index(header types.EventDataNewBlockHeader) error
+
+	// Search allows you to query for the heights of the blocks matching the
+	// query.
+	Search(q *query.Query) ([]int64, error)
 }
 
 //----------------------------------------------------
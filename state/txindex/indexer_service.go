@@ -12,24 +12,25 @@ const (
 	subscriber = "IndexerService"
 )
 
-// IndexerService connects event bus and transaction indexer together in order
-// to index transactions coming from event bus.
+// IndexerService connects event bus, transaction and block indexers together in
+// order to index transactions and blocks coming from event bus.
 type IndexerService struct {
 	service.BaseService
 
 	idr      TxIndexer
+	blockIdr BlockIndexer
 	eventBus *types.EventBus
 }
 
 // NewIndexerService returns a new service instance.
-func NewIndexerService(idr TxIndexer, eventBus *types.EventBus) *IndexerService {
-	is := &IndexerService{idr: idr, eventBus: eventBus}
+func NewIndexerService(idr TxIndexer, blockIdr BlockIndexer, eventBus *types.EventBus) *IndexerService {
+	is := &IndexerService{idr: idr, blockIdr: blockIdr, eventBus: eventBus}
 	is.BaseService = *service.NewBaseService(nil, "IndexerService", is)
 	return is
 }
 
-// OnStart implements service.Service by subscribing for all transactions
-// and indexing them by events.
+// OnStart implements service.Service by subscribing for all blocks and
+// transactions and indexing them by events.
 func (is *IndexerService) OnStart() error {
 	// Use SubscribeUnbuffered here to ensure both subscriptions does not get
 	// cancelled due to not pulling messages fast enough. Cause this might
@@ -53,6 +54,10 @@ func (is *IndexerService) OnStart() error {
 			msg := <-blockHeadersSub.Out()
 			eventDataHeader := msg.Data().(types.EventDataNewBlockHeader)
 			height := eventDataHeader.Header.Height
+			if err := is.blockIdr.Index(eventDataHeader); err != nil {
+				is.Logger.Error("Failed to index block events", "height", height, "err", err)
+			}
+
 			batch := NewBatch(eventDataHeader.NumTxs)
 			for i := int64(0); i < eventDataHeader.NumTxs; i++ {
 				msg2 := <-txsSub.Out()
@@ -26,8 +26,9 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	// tx indexer
 	store := db.NewMemDB()
 	txIndexer := kv.NewTxIndex(store, kv.IndexAllEvents())
+	blockIndexer := kv.NewBlockIndex(db.NewPrefixDB(store, []byte("block_events")))
 
-	service := txindex.NewIndexerService(txIndexer, eventBus)
+	service := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus)
 	service.SetLogger(log.TestingLogger())
 	err = service.Start()
 	require.NoError(t, err)
@@ -62,4 +63,8 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	res, err = txIndexer.Get(types.Tx("bar").Hash())
 	assert.NoError(t, err)
 	assert.Equal(t, txResult2, res)
+
+	ok, err := blockIndexer.Has(1)
+	assert.NoError(t, err)
+	assert.True(t, ok)
 }
@@ -0,0 +1,304 @@
+package kv
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	dbm "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// event types, used to tell BeginBlock and EndBlock events apart in the keys
+	eventTypeBeginBlock = "begin_block"
+	eventTypeEndBlock   = "end_block"
+)
+
+var _ txindex.BlockIndexer = (*BlockIndex)(nil)
+
+// BlockIndex indexes the BeginBlock and EndBlock events of blocks by height,
+// backed by key-value storage (levelDB). All events with a non-empty type are
+// indexed.
+type BlockIndex struct {
+	store dbm.DB
+}
+
+// NewBlockIndex creates new KV block indexer. The store may be shared with a
+// TxIndex, as long as it is wrapped in a prefix DB.
+func NewBlockIndex(store dbm.DB) *BlockIndex {
+	return &BlockIndex{store: store}
+}
+
+// Has returns true if the block at the given height has been indexed.
+func (bi *BlockIndex) Has(height int64) (bool, error) {
+	if height <= 0 {
+		return false, fmt.Errorf("height must be greater than 0, got %d", height)
+	}
+	return bi.store.Has(keyForBlockHeight(height))
+}
+
+// Index indexes the BeginBlock and EndBlock events of a block by its height.
+// Each key that indexed from the events is a composite of the event type and
+// the respective attribute's key delimited by a "." (eg. "slash.reason"). The
+// height itself is indexed under the "block.height" key.
+func (bi *BlockIndex) Index(header types.EventDataNewBlockHeader) error {
+	b := bi.store.NewBatch()
+	defer b.Close()
+
+	height := header.Header.Height
+	b.Set(keyForBlockHeight(height), heightValue(height))
+	bi.indexEvents(header.ResultBeginBlock.Events, eventTypeBeginBlock, height, b)
+	bi.indexEvents(header.ResultEndBlock.Events, eventTypeEndBlock, height, b)
+
+	return b.WriteSync()
+}
+
+func (bi *BlockIndex) indexEvents(events []abci.Event, typ string, height int64, store dbm.SetDeleter) {
+	for _, event := range events {
+		// only index events with a non-empty type
+		if len(event.Type) == 0 {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+
+			compositeTag := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
+			// the height is a reserved key, which can't be set by events
+			if compositeTag == types.BlockHeightKey {
+				continue
+			}
+			store.Set(keyForBlockEvent(compositeTag, attr.Value, height, typ), heightValue(height))
+		}
+	}
+}
+
+// Search performs a search using the given query. It breaks the query into
+// conditions (like "block.height > 5"), queries the index for each of them and
+// intersects the matching heights, which are returned in ascending order. For
+// range queries it is better for the client to provide both lower and upper
+// bounds, so we are not performing a full scan.
+func (bi *BlockIndex) Search(q *query.Query) ([]int64, error) {
+	var heightsInitialized bool
+	filteredHeights := make(map[string][]byte)
+
+	// get a list of conditions (like "block.height > 5")
+	conditions, err := q.Conditions()
+	if err != nil {
+		return nil, errors.Wrap(err, "error during parsing conditions from query")
+	}
+
+	// conditions to skip because they're handled before "everything else"
+	skipIndexes := make([]int, 0)
+
+	// extract ranges
+	ranges, rangeIndexes := lookForRanges(conditions)
+	if len(ranges) > 0 {
+		skipIndexes = append(skipIndexes, rangeIndexes...)
+
+		for _, r := range ranges {
+			if !heightsInitialized {
+				filteredHeights = bi.matchRange(r, startKey(r.key), filteredHeights, true)
+				heightsInitialized = true
+
+				// Ignore any remaining conditions if the first condition resulted
+				// in no matches (assuming implicit AND operand).
+				if len(filteredHeights) == 0 {
+					break
+				}
+			} else {
+				filteredHeights = bi.matchRange(r, startKey(r.key), filteredHeights, false)
+			}
+		}
+	}
+
+	// for all other conditions
+	for i, c := range conditions {
+		if intInSlice(i, skipIndexes) {
+			continue
+		}
+
+		if !heightsInitialized {
+			filteredHeights = bi.match(c, startKey(c.CompositeKey, c.Operand), filteredHeights, true)
+			heightsInitialized = true
+
+			// Ignore any remaining conditions if the first condition resulted
+			// in no matches (assuming implicit AND operand).
+			if len(filteredHeights) == 0 {
+				break
+			}
+		} else {
+			filteredHeights = bi.match(c, startKey(c.CompositeKey, c.Operand), filteredHeights, false)
+		}
+	}
+
+	results := make([]int64, 0, len(filteredHeights))
+	for _, value := range filteredHeights {
+		height, err := strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse indexed height %q", value)
+		}
+		results = append(results, height)
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+
+	return results, nil
+}
+
+// match returns all matching heights that meet a given condition and start
+// key. An already filtered result (filteredHeights) is provided such that any
+// non-intersecting matches are removed.
+//
+// NOTE: filteredHeights may be empty if no previous condition has matched.
+func (bi *BlockIndex) match(
+	c query.Condition,
+	startKeyBz []byte,
+	filteredHeights map[string][]byte,
+	firstRun bool,
+) map[string][]byte {
+	// A previous match was attempted but resulted in no matches, so we return
+	// no matches (assuming AND operand).
+	if !firstRun && len(filteredHeights) == 0 {
+		return filteredHeights
+	}
+
+	tmpHeights := make(map[string][]byte)
+
+	switch {
+	case c.Op == query.OpEqual:
+		it, err := dbm.IteratePrefix(bi.store, startKeyBz)
+		if err != nil {
+			panic(err)
+		}
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			tmpHeights[string(it.Value())] = it.Value()
+		}
+
+	case c.Op == query.OpContains:
+		// XXX: startKey does not apply here, see TxIndex.match.
+		it, err := dbm.IteratePrefix(bi.store, startKey(c.CompositeKey))
+		if err != nil {
+			panic(err)
+		}
+		defer it.Close()
+
+		for ; it.Valid(); it.Next() {
+			if strings.Contains(extractValueFromKey(it.Key()), c.Operand.(string)) {
+				tmpHeights[string(it.Value())] = it.Value()
+			}
+		}
+	default:
+		panic("other operators should be handled already")
+	}
+
+	return intersectHeights(filteredHeights, tmpHeights, firstRun)
+}
+
+// matchRange returns all matching heights that meet a given queryRange and
+// start key. An already filtered result (filteredHeights) is provided such
+// that any non-intersecting matches are removed.
+//
+// NOTE: filteredHeights may be empty if no previous condition has matched.
+func (bi *BlockIndex) matchRange(
+	r queryRange,
+	startKey []byte,
+	filteredHeights map[string][]byte,
+	firstRun bool,
+) map[string][]byte {
+	// A previous match was attempted but resulted in no matches, so we return
+	// no matches (assuming AND operand).
+	if !firstRun && len(filteredHeights) == 0 {
+		return filteredHeights
+	}
+
+	tmpHeights := make(map[string][]byte)
+	lowerBound := r.lowerBoundValue()
+	upperBound := r.upperBoundValue()
+
+	it, err := dbm.IteratePrefix(bi.store, startKey)
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if _, ok := r.AnyBound().(int64); !ok {
+			// XXX: passing time in a ABCI Events is not yet implemented
+			break
+		}
+
+		v, err := strconv.ParseInt(extractValueFromKey(it.Key()), 10, 64)
+		if err != nil {
+			continue
+		}
+		if lowerBound != nil && v < lowerBound.(int64) {
+			continue
+		}
+		if upperBound != nil && v > upperBound.(int64) {
+			continue
+		}
+		tmpHeights[string(it.Value())] = it.Value()
+	}
+
+	return intersectHeights(filteredHeights, tmpHeights, firstRun)
+}
+
+// intersectHeights removes the heights in filteredHeights which were not
+// matched by the current condition (tmpHeights), and returns them.
+func intersectHeights(filteredHeights, tmpHeights map[string][]byte, firstRun bool) map[string][]byte {
+	if len(tmpHeights) == 0 || firstRun {
+		// Either:
+		//
+		// 1. Regardless if a previous match was attempted, which may have had
+		// results, but no match was found for the current condition, then we
+		// return no matches (assuming AND operand).
+		//
+		// 2. A previous match was not attempted, so we return all results.
+		return tmpHeights
+	}
+
+	for k := range filteredHeights {
+		if tmpHeights[k] == nil {
+			delete(filteredHeights, k)
+		}
+	}
+
+	return filteredHeights
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Keys
+
+func heightValue(height int64) []byte {
+	return []byte(strconv.FormatInt(height, 10))
+}
+
+func keyForBlockHeight(height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%d",
+		types.BlockHeightKey,
+		height,
+		height,
+	))
+}
+
+func keyForBlockEvent(key string, value []byte, height int64, typ string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d/%s",
+		key,
+		value,
+		height,
+		typ,
+	))
+}
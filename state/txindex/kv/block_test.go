@@ -0,0 +1,102 @@
+package kv
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestBlockIndex(t *testing.T) {
+	indexer := NewBlockIndex(db.NewMemDB())
+
+	ok, err := indexer.Has(1)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = indexer.Has(0)
+	assert.Error(t, err)
+
+	err = indexer.Index(types.EventDataNewBlockHeader{Header: types.Header{Height: 1}})
+	require.NoError(t, err)
+
+	ok, err = indexer.Has(1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestBlockSearch(t *testing.T) {
+	indexer := NewBlockIndex(db.NewMemDB())
+
+	for height := int64(1); height <= 10; height++ {
+		header := types.EventDataNewBlockHeader{
+			Header: types.Header{Height: height},
+			ResultBeginBlock: abci.ResponseBeginBlock{
+				Events: []abci.Event{
+					{Type: "begin_event", Attributes: []kv.Pair{
+						{Key: []byte("proposer"), Value: []byte(fmt.Sprintf("FCAA00%d", height%3))},
+						// the height can't be overridden by events
+						{Key: []byte("height"), Value: []byte("100")},
+					}},
+					{Type: "block", Attributes: []kv.Pair{
+						{Key: []byte("height"), Value: []byte("100")},
+					}},
+				},
+			},
+			ResultEndBlock: abci.ResponseEndBlock{
+				Events: []abci.Event{
+					{Type: "end_event", Attributes: []kv.Pair{
+						{Key: []byte("foo"), Value: []byte(fmt.Sprintf("%d", height))},
+					}},
+					{Type: "", Attributes: []kv.Pair{
+						{Key: []byte("not_indexed"), Value: []byte("bar")},
+					}},
+				},
+			},
+		}
+		require.NoError(t, indexer.Index(header))
+	}
+
+	testCases := []struct {
+		q       string
+		results []int64
+	}{
+		// search by height
+		{q: "block.height = 5", results: []int64{5}},
+		// search by height range
+		{q: "block.height >= 3 AND block.height < 6", results: []int64{3, 4, 5}},
+		// height can't be overridden by events
+		{q: "block.height = 100", results: []int64{}},
+		// search by begin block event
+		{q: "begin_event.proposer = 'FCAA001'", results: []int64{1, 4, 7, 10}},
+		// search by begin block event and height range
+		{q: "begin_event.proposer = 'FCAA001' AND block.height > 5", results: []int64{7, 10}},
+		// search by end block event range
+		{q: "end_event.foo <= 2", results: []int64{1, 2}},
+		// search by contains
+		{q: "begin_event.proposer CONTAINS 'AA002'", results: []int64{2, 5, 8}},
+		// search by non-matching conditions
+		{q: "begin_event.proposer = 'FCAA001' AND end_event.foo = 2", results: []int64{}},
+		// search by empty event type
+		{q: ".not_indexed = 'bar'", results: []int64{}},
+		// search by unknown key
+		{q: "unknown.key = 'value'", results: []int64{}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := indexer.Search(query.MustParse(tc.q))
+			require.NoError(t, err)
+			assert.Equal(t, tc.results, results)
+		})
+	}
+}
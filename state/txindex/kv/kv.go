@@ -4,15 +4,19 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmmath "github.com/tendermint/tendermint/libs/math"
 	"github.com/tendermint/tendermint/libs/pubsub/query"
 	tmstring "github.com/tendermint/tendermint/libs/strings"
 	"github.com/tendermint/tendermint/state/txindex"
@@ -21,6 +25,12 @@ import (
 
 const (
 	tagKeySeparator = "/"
+
+	// txPositionKey prefixes the index of the tx hashes by height & index.
+	txPositionKey = "tx.position"
+	// txPositionMigratedKey is set once the position index has been built for
+	// the txs indexed before it existed.
+	txPositionMigratedKey = "tx.position.migrated"
 )
 
 var _ txindex.TxIndexer = (*TxIndex)(nil)
@@ -30,6 +40,9 @@ type TxIndex struct {
 	store                dbm.DB
 	compositeKeysToIndex []string
 	indexAllEvents       bool
+
+	migrateOnce sync.Once
+	migrateErr  error
 }
 
 // NewTxIndex creates new KV indexer.
@@ -41,6 +54,51 @@ func NewTxIndex(store dbm.DB, options ...func(*TxIndex)) *TxIndex {
 	return txi
 }
 
+// migratePositions builds the position index of the txs indexed before it
+// existed, which are found by their hash keys. It only runs once per store,
+// before the first search which walks the position index: as it iterates over
+// the whole store, it can take a while for a large store, so it isn't run
+// when the node starts, nor for the searches which don't need it.
+func (txi *TxIndex) migratePositions() error {
+	txi.migrateOnce.Do(func() {
+		txi.migrateErr = txi.doMigratePositions()
+	})
+	return txi.migrateErr
+}
+
+func (txi *TxIndex) doMigratePositions() error {
+	migrated, err := txi.store.Has([]byte(txPositionMigratedKey))
+	if err != nil {
+		return err
+	} else if migrated {
+		return nil
+	}
+
+	it, err := txi.store.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	b := txi.store.NewBatch()
+	defer b.Close()
+	for ; it.Valid(); it.Next() {
+		if len(it.Key()) != tmhash.Size {
+			continue
+		}
+		txResult := new(types.TxResult)
+		if err := cdc.UnmarshalBinaryBare(it.Value(), &txResult); err != nil {
+			continue
+		}
+		if bytes.Equal(txResult.Tx.Hash(), it.Key()) {
+			b.Set(keyForPosition(txResult.Height, txResult.Index), it.Key())
+		}
+	}
+	b.Set([]byte(txPositionMigratedKey), []byte{1})
+	b.WriteSync()
+	return nil
+}
+
 // IndexEvents is an option for setting which composite keys to index.
 func IndexEvents(compositeKeys []string) func(*TxIndex) {
 	return func(txi *TxIndex) {
@@ -98,6 +156,9 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 			storeBatch.Set(keyForHeight(result), hash)
 		}
 
+		// index tx by position
+		storeBatch.Set(keyForPosition(result.Height, result.Index), hash)
+
 		// index tx by hash
 		rawBytes, err := cdc.MarshalBinaryBare(result)
 		if err != nil {
@@ -128,6 +189,9 @@ func (txi *TxIndex) Index(result *types.TxResult) error {
 		b.Set(keyForHeight(result), hash)
 	}
 
+	// index tx by position
+	b.Set(keyForPosition(result.Height, result.Index), hash)
+
 	// index tx by hash
 	rawBytes, err := cdc.MarshalBinaryBare(result)
 	if err != nil {
@@ -160,15 +224,33 @@ func (txi *TxIndex) indexEvents(result *types.TxResult, hash []byte, store dbm.S
 	}
 }
 
-// Search performs a search using the given query. It breaks the query into
-// conditions (like "tx.height > 5"). For each condition, it queries the DB
-// index. One special use cases here: (1) if "tx.hash" is found, it returns tx
-// result for it (2) for range queries it is better for the client to provide
-// both lower and upper bounds, so we are not performing a full scan. Results
-// from querying indexes are then intersected and returned to the caller.
+// Search performs a search using the given query, and returns all the
+// matching txs sorted by height & index. See SearchPage.
 func (txi *TxIndex) Search(q *query.Query) ([]*types.TxResult, error) {
-	var hashesInitialized bool
-	filteredHashes := make(map[string][]byte)
+	res, err := txi.SearchPage(q, txindex.SearchOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Txs, nil
+}
+
+// SearchPage performs a search using the given query. It breaks the query into
+// conditions (like "tx.height > 5"). If "tx.hash" is found, it returns the tx
+// result for it. If an event is looked for by equality, the txs are found by
+// its event index, and checked against the other conditions. Otherwise it walks
+// the position index in height & index order (in reverse for OrderDesc), within
+// the bounds of the "tx.height" conditions, and checks each tx against the
+// conditions. For range queries on the events, the tx has to be loaded, so it
+// is better for the client to provide an equality condition or a "tx.height"
+// range as well.
+//
+// For the position index, the page is read from the cursor on, and the walk
+// stops after Limit matches. The total count walks the matches again, without
+// loading them.
+func (txi *TxIndex) SearchPage(q *query.Query, opts txindex.SearchOptions) (*txindex.SearchResult, error) {
+	if err := opts.ValidateBasic(); err != nil {
+		return nil, err
+	}
 
 	// get a list of conditions (like "tx.height > 5")
 	conditions, err := q.Conditions()
@@ -181,104 +263,365 @@ func (txi *TxIndex) Search(q *query.Query) ([]*types.TxResult, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "error during searching for a hash in the query")
 	} else if ok {
-		res, err := txi.Get(hash)
-		switch {
-		case err != nil:
-			return []*types.TxResult{}, errors.Wrap(err, "error while retrieving the result")
-		case res == nil:
-			return []*types.TxResult{}, nil
-		default:
-			return []*types.TxResult{res}, nil
+		return txi.searchHash(hash, opts)
+	}
+
+	if i, ok := lookForEventEquality(conditions); ok {
+		return txi.searchEvent(conditions, i, opts)
+	}
+
+	if err := txi.migratePositions(); err != nil {
+		return nil, errors.Wrap(err, "failed to build the position index")
+	}
+	start, end := positionRange(conditions)
+	totalCount, err := txi.countMatches(conditions, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	// skip the positions up to (and including) the cursor
+	if opts.Cursor != "" {
+		height, index, _ := txindex.DecodeCursor(opts.Cursor)
+		cursor := keyForPosition(height, index)
+		if opts.Descending() {
+			if bytes.Compare(cursor, end) < 0 {
+				end = cursor
+			}
+		} else if cursor = append(cursor, 0x00); bytes.Compare(cursor, start) > 0 {
+			start = cursor
 		}
 	}
 
-	// conditions to skip because they're handled before "everything else"
-	skipIndexes := make([]int, 0)
+	it, err := txi.positionIterator(start, end, opts.Descending())
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
 
-	// extract ranges
-	// if both upper and lower bounds exist, it's better to get them in order not
-	// no iterate over kvs that are not within range.
-	ranges, rangeIndexes := lookForRanges(conditions)
-	if len(ranges) > 0 {
-		skipIndexes = append(skipIndexes, rangeIndexes...)
+	results := make([]*types.TxResult, 0)
+	var nextCursor string
+	for ; it.Valid(); it.Next() {
+		ok, err := txi.matchPosition(conditions, it.Key(), it.Value())
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+		if opts.Limit > 0 && len(results) == opts.Limit {
+			last := results[len(results)-1]
+			nextCursor = txindex.EncodeCursor(last.Height, last.Index)
+			break
+		}
+		res, err := txi.Get(it.Value())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get Tx{%X}", it.Value())
+		}
+		results = append(results, res)
+	}
 
-		for _, r := range ranges {
-			if !hashesInitialized {
-				filteredHashes = txi.matchRange(r, startKey(r.key), filteredHashes, true)
-				hashesInitialized = true
+	return &txindex.SearchResult{
+		Txs:        results,
+		TotalCount: totalCount,
+		NextCursor: nextCursor,
+	}, nil
+}
 
-				// Ignore any remaining conditions if the first condition resulted
-				// in no matches (assuming implicit AND operand).
-				if len(filteredHashes) == 0 {
-					break
-				}
-			} else {
-				filteredHashes = txi.matchRange(r, startKey(r.key), filteredHashes, false)
-			}
+// searchHash returns the page of the tx with the given hash, if it's stored and
+// comes after the cursor.
+func (txi *TxIndex) searchHash(hash []byte, opts txindex.SearchOptions) (*txindex.SearchResult, error) {
+	res, err := txi.Get(hash)
+	switch {
+	case err != nil:
+		return nil, errors.Wrap(err, "error while retrieving the result")
+	case res == nil:
+		return &txindex.SearchResult{Txs: []*types.TxResult{}}, nil
+	}
+
+	txs := []*types.TxResult{res}
+	if opts.Cursor != "" {
+		height, index, _ := txindex.DecodeCursor(opts.Cursor)
+		cmp := bytes.Compare(keyForPosition(res.Height, res.Index), keyForPosition(height, index))
+		if opts.Descending() && cmp >= 0 || !opts.Descending() && cmp <= 0 {
+			txs = []*types.TxResult{}
 		}
 	}
+	return &txindex.SearchResult{Txs: txs, TotalCount: 1}, nil
+}
 
-	// if there is a height condition ("tx.height=3"), extract it
-	height := lookForHeight(conditions)
+// searchEvent returns the page of the txs matching the conditions, which are
+// found by the event index of the equality condition conditions[i]. Its keys
+// aren't ordered by height & index, so all the matches are sorted by position
+// before the page is read from the cursor on: only the txs of the page are
+// loaded.
+func (txi *TxIndex) searchEvent(
+	conditions []query.Condition,
+	i int,
+	opts txindex.SearchOptions,
+) (*txindex.SearchResult, error) {
+	others := make([]query.Condition, 0, len(conditions)-1)
+	others = append(others, conditions[:i]...)
+	others = append(others, conditions[i+1:]...)
+	start, end := positionRange(others)
+
+	prefix := startKey(conditions[i].CompositeKey, conditions[i].Operand)
+	it, err := dbm.IteratePrefix(txi.store, prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
 
-	// for all other conditions
-	for i, c := range conditions {
-		if intInSlice(i, skipIndexes) {
+	var matches []txPosition
+	for ; it.Valid(); it.Next() {
+		height, index, ok := positionFromEventKey(it.Key()[len(prefix):])
+		if !ok {
+			// another value, which has the operand as a prefix
+			continue
+		}
+		key := keyForPosition(height, index)
+		if bytes.Compare(key, start) < 0 || bytes.Compare(key, end) >= 0 {
 			continue
 		}
+		ok, err := txi.matchPosition(others, key, it.Value())
+		if err != nil {
+			return nil, err
+		} else if ok {
+			matches = append(matches, txPosition{key: key, hash: it.Value()})
+		}
+	}
 
-		if !hashesInitialized {
-			filteredHashes = txi.match(c, startKeyForCondition(c, height), filteredHashes, true)
-			hashesInitialized = true
+	sort.Slice(matches, func(i, j int) bool {
+		if opts.Descending() {
+			return bytes.Compare(matches[i].key, matches[j].key) > 0
+		}
+		return bytes.Compare(matches[i].key, matches[j].key) < 0
+	})
 
-			// Ignore any remaining conditions if the first condition resulted
-			// in no matches (assuming implicit AND operand).
-			if len(filteredHashes) == 0 {
-				break
+	// skip the positions up to (and including) the cursor
+	page := matches
+	if opts.Cursor != "" {
+		height, index, _ := txindex.DecodeCursor(opts.Cursor)
+		cursor := keyForPosition(height, index)
+		page = page[sort.Search(len(page), func(i int) bool {
+			if opts.Descending() {
+				return bytes.Compare(page[i].key, cursor) < 0
 			}
-		} else {
-			filteredHashes = txi.match(c, startKeyForCondition(c, height), filteredHashes, false)
-		}
+			return bytes.Compare(page[i].key, cursor) > 0
+		}):]
+	}
+	hasNext := opts.Limit > 0 && len(page) > opts.Limit
+	if hasNext {
+		page = page[:opts.Limit]
 	}
 
-	results := make([]*types.TxResult, 0, len(filteredHashes))
-	for _, h := range filteredHashes {
-		res, err := txi.Get(h)
+	results := make([]*types.TxResult, 0, len(page))
+	for _, match := range page {
+		res, err := txi.Get(match.hash)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get Tx{%X}", h)
+			return nil, errors.Wrapf(err, "failed to get Tx{%X}", match.hash)
 		}
 		results = append(results, res)
 	}
+	var nextCursor string
+	if hasNext {
+		last := results[len(results)-1]
+		nextCursor = txindex.EncodeCursor(last.Height, last.Index)
+	}
+
+	return &txindex.SearchResult{
+		Txs:        results,
+		TotalCount: len(matches),
+		NextCursor: nextCursor,
+	}, nil
+}
 
-	// sort by height & index by default
-	sort.Slice(results, func(i, j int) bool {
-		if results[i].Height == results[j].Height {
-			return results[i].Index < results[j].Index
+// txPosition is the position key of a tx, with its hash.
+type txPosition struct {
+	key  []byte
+	hash []byte
+}
+
+// countMatches returns the number of txs between the start and end positions
+// which match the conditions.
+func (txi *TxIndex) countMatches(conditions []query.Condition, start, end []byte) (int, error) {
+	it, err := txi.positionIterator(start, end, false)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	count := 0
+	for ; it.Valid(); it.Next() {
+		ok, err := txi.matchPosition(conditions, it.Key(), it.Value())
+		if err != nil {
+			return 0, err
+		} else if ok {
+			count++
 		}
-		return results[i].Height < results[j].Height
-	})
+	}
+	return count, nil
+}
 
-	return results, nil
+func (txi *TxIndex) positionIterator(start, end []byte, descending bool) (dbm.Iterator, error) {
+	if bytes.Compare(start, end) >= 0 {
+		// an empty range, which the iterators don't support
+		end = start
+	}
+	if descending {
+		return txi.store.ReverseIterator(start, end)
+	}
+	return txi.store.Iterator(start, end)
 }
 
-func lookForHash(conditions []query.Condition) (hash []byte, ok bool, err error) {
+// matchPosition returns true if the tx at the given position key matches all the
+// conditions. "tx.height" conditions are matched against the position, equality
+// conditions against the event index, and the other ones against the indexed
+// events of the tx, which is loaded for them.
+func (txi *TxIndex) matchPosition(conditions []query.Condition, key, hash []byte) (bool, error) {
+	height, index, err := positionFromKey(key)
+	if err != nil {
+		return false, err
+	}
+
+	var txResult *types.TxResult
 	for _, c := range conditions {
-		if c.CompositeKey == types.TxHashKey {
-			decoded, err := hex.DecodeString(c.Operand.(string))
-			return decoded, true, err
+		switch {
+		case c.CompositeKey == types.TxHeightKey:
+			if !matchValue(c, strconv.FormatInt(height, 10)) {
+				return false, nil
+			}
+
+		case c.Op == query.OpEqual:
+			indexed, err := txi.store.Get([]byte(fmt.Sprintf("%s/%v/%d/%d", c.CompositeKey, c.Operand, height, index)))
+			if err != nil {
+				return false, err
+			} else if !bytes.Equal(indexed, hash) {
+				return false, nil
+			}
+
+		default:
+			if txResult == nil {
+				if txResult, err = txi.Get(hash); err != nil {
+					return false, errors.Wrapf(err, "failed to get Tx{%X}", hash)
+				} else if txResult == nil {
+					return false, nil
+				}
+			}
+			ok, err := txi.matchEvents(c, txResult)
+			if err != nil || !ok {
+				return false, err
+			}
 		}
 	}
-	return
+	return true, nil
+}
+
+// matchEvents returns true if any indexed event attribute of the tx matches the
+// condition.
+func (txi *TxIndex) matchEvents(c query.Condition, result *types.TxResult) (bool, error) {
+	for _, event := range result.Result.Events {
+		for _, attr := range event.Attributes {
+			if fmt.Sprintf("%s.%s", event.Type, attr.Key) != c.CompositeKey || !matchValue(c, string(attr.Value)) {
+				continue
+			}
+			// only the indexed values match, as when querying the event index
+			indexed, err := txi.store.Get(keyForEvent(c.CompositeKey, attr.Value, result))
+			if err != nil {
+				return false, err
+			} else if bytes.Equal(indexed, result.Tx.Hash()) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// matchValue returns true if the value satisfies the condition. Ranges are only
+// supported for integers.
+func matchValue(c query.Condition, value string) bool {
+	switch c.Op {
+	case query.OpExists:
+		return true
+	case query.OpContains:
+		operand, ok := c.Operand.(string)
+		return ok && strings.Contains(value, operand)
+	case query.OpEqual:
+		return value == fmt.Sprintf("%v", c.Operand)
+	}
+
+	operand, ok := c.Operand.(int64)
+	if !ok {
+		return false
+	}
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false
+	}
+	switch c.Op {
+	case query.OpGreater:
+		return v > operand
+	case query.OpGreaterEqual:
+		return v >= operand
+	case query.OpLess:
+		return v < operand
+	case query.OpLessEqual:
+		return v <= operand
+	default:
+		return false
+	}
 }
 
-// lookForHeight returns a height if there is an "height=X" condition.
-func lookForHeight(conditions []query.Condition) (height int64) {
+// positionRange returns the range of the position index within the bounds of
+// the "tx.height" conditions.
+func positionRange(conditions []query.Condition) (start, end []byte) {
+	var lower, upper int64 = 0, math.MaxInt64
 	for _, c := range conditions {
-		if c.CompositeKey == types.TxHeightKey && c.Op == query.OpEqual {
-			return c.Operand.(int64)
+		height, ok := c.Operand.(int64)
+		if c.CompositeKey != types.TxHeightKey || !ok {
+			continue
 		}
+		switch c.Op {
+		case query.OpEqual:
+			lower, upper = tmmath.MaxInt64(lower, height), tmmath.MinInt64(upper, height)
+		case query.OpGreater:
+			lower = tmmath.MaxInt64(lower, height+1)
+		case query.OpGreaterEqual:
+			lower = tmmath.MaxInt64(lower, height)
+		case query.OpLess:
+			upper = tmmath.MinInt64(upper, height-1)
+		case query.OpLessEqual:
+			upper = tmmath.MinInt64(upper, height)
+		}
+	}
+
+	start = keyForPosition(lower, 0)
+	if upper < lower {
+		return start, start
 	}
-	return 0
+	if upper == math.MaxInt64 {
+		return start, append([]byte(txPositionKey), tagKeySeparator[0]+1)
+	}
+	return start, keyForPosition(upper+1, 0)
+}
+
+// lookForEventEquality returns the index of the first equality condition on an
+// event, if any.
+func lookForEventEquality(conditions []query.Condition) (int, bool) {
+	for i, c := range conditions {
+		if c.Op == query.OpEqual && c.CompositeKey != types.TxHeightKey && c.CompositeKey != types.TxHashKey {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func lookForHash(conditions []query.Condition) (hash []byte, ok bool, err error) {
+	for _, c := range conditions {
+		if c.CompositeKey == types.TxHashKey {
+			decoded, err := hex.DecodeString(c.Operand.(string))
+			return decoded, true, err
+		}
+	}
+	return
 }
 
 // special map to hold range conditions
@@ -375,175 +718,55 @@ func isRangeOperation(op query.Operator) bool {
 	}
 }
 
-// match returns all matching txs by hash that meet a given condition and start
-// key. An already filtered result (filteredHashes) is provided such that any
-// non-intersecting matches are removed.
-//
-// NOTE: filteredHashes may be empty if no previous condition has matched.
-func (txi *TxIndex) match(
-	c query.Condition,
-	startKeyBz []byte,
-	filteredHashes map[string][]byte,
-	firstRun bool,
-) map[string][]byte {
-	// A previous match was attempted but resulted in no matches, so we return
-	// no matches (assuming AND operand).
-	if !firstRun && len(filteredHashes) == 0 {
-		return filteredHashes
-	}
-
-	tmpHashes := make(map[string][]byte)
-
-	switch {
-	case c.Op == query.OpEqual:
-		it, err := dbm.IteratePrefix(txi.store, startKeyBz)
-		if err != nil {
-			panic(err)
-		}
-		defer it.Close()
-
-		for ; it.Valid(); it.Next() {
-			tmpHashes[string(it.Value())] = it.Value()
-		}
-
-	case c.Op == query.OpContains:
-		// XXX: startKey does not apply here.
-		// For example, if startKey = "account.owner/an/" and search query = "account.owner CONTAINS an"
-		// we can't iterate with prefix "account.owner/an/" because we might miss keys like "account.owner/Ulan/"
-		it, err := dbm.IteratePrefix(txi.store, startKey(c.CompositeKey))
-		if err != nil {
-			panic(err)
-		}
-		defer it.Close()
-
-		for ; it.Valid(); it.Next() {
-			if !isTagKey(it.Key()) {
-				continue
-			}
+///////////////////////////////////////////////////////////////////////////////
+// Keys
 
-			if strings.Contains(extractValueFromKey(it.Key()), c.Operand.(string)) {
-				tmpHashes[string(it.Value())] = it.Value()
-			}
-		}
-	default:
-		panic("other operators should be handled already")
-	}
+func extractValueFromKey(key []byte) string {
+	parts := strings.SplitN(string(key), tagKeySeparator, 3)
+	return parts[1]
+}
 
-	if len(tmpHashes) == 0 || firstRun {
-		// Either:
-		//
-		// 1. Regardless if a previous match was attempted, which may have had
-		// results, but no match was found for the current condition, then we
-		// return no matches (assuming AND operand).
-		//
-		// 2. A previous match was not attempted, so we return all results.
-		return tmpHashes
+// positionFromKey returns the height & index of the tx at the given position
+// key.
+func positionFromKey(key []byte) (height int64, index uint32, err error) {
+	parts := strings.Split(string(key), tagKeySeparator)
+	if len(parts) != 3 || parts[0] != txPositionKey {
+		return 0, 0, fmt.Errorf("invalid position key %q", key)
 	}
-
-	// Remove/reduce matches in filteredHashes that were not found in this
-	// match (tmpHashes).
-	for k := range filteredHashes {
-		if tmpHashes[k] == nil {
-			delete(filteredHashes, k)
-		}
+	height, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "invalid position key %q", key)
 	}
-
-	return filteredHashes
-}
-
-// matchRange returns all matching txs by hash that meet a given queryRange and
-// start key. An already filtered result (filteredHashes) is provided such that
-// any non-intersecting matches are removed.
-//
-// NOTE: filteredHashes may be empty if no previous condition has matched.
-func (txi *TxIndex) matchRange(
-	r queryRange,
-	startKey []byte,
-	filteredHashes map[string][]byte,
-	firstRun bool,
-) map[string][]byte {
-	// A previous match was attempted but resulted in no matches, so we return
-	// no matches (assuming AND operand).
-	if !firstRun && len(filteredHashes) == 0 {
-		return filteredHashes
-	}
-
-	tmpHashes := make(map[string][]byte)
-	lowerBound := r.lowerBoundValue()
-	upperBound := r.upperBoundValue()
-
-	it, err := dbm.IteratePrefix(txi.store, startKey)
+	idx, err := strconv.ParseUint(parts[2], 10, 32)
 	if err != nil {
-		panic(err)
+		return 0, 0, errors.Wrapf(err, "invalid position key %q", key)
 	}
-	defer it.Close()
-
-LOOP:
-	for ; it.Valid(); it.Next() {
-		if !isTagKey(it.Key()) {
-			continue
-		}
-
-		if _, ok := r.AnyBound().(int64); ok {
-			v, err := strconv.ParseInt(extractValueFromKey(it.Key()), 10, 64)
-			if err != nil {
-				continue LOOP
-			}
-
-			include := true
-			if lowerBound != nil && v < lowerBound.(int64) {
-				include = false
-			}
-
-			if upperBound != nil && v > upperBound.(int64) {
-				include = false
-			}
-
-			if include {
-				tmpHashes[string(it.Value())] = it.Value()
-			}
+	return height, uint32(idx), nil
+}
 
-			// XXX: passing time in a ABCI Events is not yet implemented
-			// case time.Time:
-			// 	v := strconv.ParseInt(extractValueFromKey(it.Key()), 10, 64)
-			// 	if v == r.upperBound {
-			// 		break
-			// 	}
-		}
+// positionFromEventKey returns the height & index of the tx at the end of an
+// event key, after its composite key & value.
+func positionFromEventKey(suffix []byte) (height int64, index uint32, ok bool) {
+	parts := strings.Split(string(suffix), tagKeySeparator)
+	if len(parts) != 2 {
+		return 0, 0, false
 	}
-
-	if len(tmpHashes) == 0 || firstRun {
-		// Either:
-		//
-		// 1. Regardless if a previous match was attempted, which may have had
-		// results, but no match was found for the current condition, then we
-		// return no matches (assuming AND operand).
-		//
-		// 2. A previous match was not attempted, so we return all results.
-		return tmpHashes
+	height, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, false
 	}
-
-	// Remove/reduce matches in filteredHashes that were not found in this
-	// match (tmpHashes).
-	for k := range filteredHashes {
-		if tmpHashes[k] == nil {
-			delete(filteredHashes, k)
-		}
+	idx, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, false
 	}
-
-	return filteredHashes
+	return height, uint32(idx), true
 }
 
-///////////////////////////////////////////////////////////////////////////////
-// Keys
-
-func isTagKey(key []byte) bool {
-	return strings.Count(string(key), tagKeySeparator) == 3
-}
-
-func extractValueFromKey(key []byte) string {
-	parts := strings.SplitN(string(key), tagKeySeparator, 3)
-	return parts[1]
+// keyForPosition returns the key of the tx at the given height & index in the
+// position index. The numbers are zero-padded, so that the keys are ordered by
+// height & index.
+func keyForPosition(height int64, index uint32) []byte {
+	return []byte(fmt.Sprintf("%s/%020d/%010d", txPositionKey, height, index))
 }
 
 func keyForEvent(key string, value []byte, result *types.TxResult) []byte {
@@ -564,13 +787,6 @@ func keyForHeight(result *types.TxResult) []byte {
 	))
 }
 
-func startKeyForCondition(c query.Condition, height int64) []byte {
-	if height > 0 {
-		return startKey(c.CompositeKey, c.Operand, height)
-	}
-	return startKey(c.CompositeKey, c.Operand)
-}
-
 func startKey(fields ...interface{}) []byte {
 	var b bytes.Buffer
 	for _, f := range fields {
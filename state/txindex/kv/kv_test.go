@@ -148,6 +148,7 @@ func TestTxSearchDeprecatedIndexing(t *testing.T) {
 	// index tx also using deprecated indexing (event as key)
 	txResult2 := txResultWithEvents(nil)
 	txResult2.Tx = types.Tx("HELLO WORLD 2")
+	txResult2.Index = 1
 
 	hash2 := txResult2.Tx.Hash()
 	b := indexer.store.NewBatch()
@@ -164,6 +165,7 @@ func TestTxSearchDeprecatedIndexing(t *testing.T) {
 
 	b.Set(depKey, hash2)
 	b.Set(keyForHeight(txResult2), hash2)
+	b.Set(keyForPosition(txResult2.Height, txResult2.Index), hash2)
 	b.Set(hash2, rawBytes)
 	b.Write()
 
@@ -275,6 +277,179 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	assert.Equal(t, []*types.TxResult{txResult3, txResult2, txResult}, results)
 }
 
+func TestTxSearchPage(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB(), IndexAllEvents())
+
+	// index 6 txs: 2 per height, at heights 1 to 3
+	var txResults []*types.TxResult
+	for height := int64(1); height <= 3; height++ {
+		for index := uint32(0); index < 2; index++ {
+			txResult := txResultWithEvents([]abci.Event{
+				{Type: "account", Attributes: []kv.Pair{{Key: []byte("number"), Value: []byte("1")}}},
+			})
+			txResult.Tx = types.Tx(fmt.Sprintf("tx %d %d", height, index))
+			txResult.Height = height
+			txResult.Index = index
+			txResults = append(txResults, txResult)
+		}
+	}
+	// index them out of order
+	for _, i := range []int{3, 0, 5, 1, 4, 2} {
+		require.NoError(t, indexer.Index(txResults[i]))
+	}
+	reversed := make([]*types.TxResult, len(txResults))
+	for i, txResult := range txResults {
+		reversed[len(txResults)-1-i] = txResult
+	}
+
+	q := query.MustParse("account.number = 1")
+
+	testCases := map[string]struct {
+		orderBy string
+		limit   int
+		expect  []*types.TxResult
+	}{
+		"no limit":         {"", 0, txResults},
+		"asc no limit":     {txindex.OrderAsc, 0, txResults},
+		"desc no limit":    {txindex.OrderDesc, 0, reversed},
+		"asc limit 4":      {txindex.OrderAsc, 4, txResults},
+		"desc limit 4":     {txindex.OrderDesc, 4, reversed},
+		"asc limit 3":      {txindex.OrderAsc, 3, txResults},
+		"desc limit 1":     {txindex.OrderDesc, 1, reversed},
+		"asc limit larger": {txindex.OrderAsc, 10, txResults},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// follow the cursors until there are no more results
+			opts := txindex.SearchOptions{OrderBy: tc.orderBy, Limit: tc.limit}
+			results := make([]*types.TxResult, 0)
+			for {
+				res, err := indexer.SearchPage(q, opts)
+				require.NoError(t, err)
+				assert.Equal(t, len(txResults), res.TotalCount)
+				if tc.limit > 0 {
+					assert.LessOrEqual(t, len(res.Txs), tc.limit)
+				}
+				results = append(results, res.Txs...)
+				if res.NextCursor == "" {
+					break
+				}
+				opts.Cursor = res.NextCursor
+			}
+			assert.Equal(t, tc.expect, results)
+		})
+	}
+
+	// no matches
+	res, err := indexer.SearchPage(query.MustParse("account.number = 2"), txindex.SearchOptions{Limit: 1})
+	require.NoError(t, err)
+	assert.Empty(t, res.Txs)
+	assert.Zero(t, res.TotalCount)
+	assert.Empty(t, res.NextCursor)
+
+	// invalid options
+	_, err = indexer.SearchPage(q, txindex.SearchOptions{OrderBy: "foo"})
+	assert.Error(t, err)
+	_, err = indexer.SearchPage(q, txindex.SearchOptions{Cursor: "foo"})
+	assert.Error(t, err)
+	_, err = indexer.SearchPage(q, txindex.SearchOptions{Limit: -1})
+	assert.Error(t, err)
+}
+
+func TestTxSearchPageHeights(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB(), IndexAllEvents())
+
+	// the heights and indexes aren't ordered by their decimal strings
+	var txResults []*types.TxResult
+	for _, height := range []int64{2, 9, 10, 100} {
+		for _, index := range []uint32{1, 2, 10} {
+			txResult := txResultWithEvents([]abci.Event{
+				{Type: "account", Attributes: []kv.Pair{{Key: []byte("number"), Value: []byte(fmt.Sprint(index))}}},
+			})
+			txResult.Tx = types.Tx(fmt.Sprintf("tx %d %d", height, index))
+			txResult.Height = height
+			txResult.Index = index
+			require.NoError(t, indexer.Index(txResult))
+			txResults = append(txResults, txResult)
+		}
+	}
+
+	testCases := []struct {
+		q       string
+		opts    txindex.SearchOptions
+		expect  []*types.TxResult
+		total   int
+		hasNext bool
+	}{
+		{"tx.height >= 2", txindex.SearchOptions{}, txResults, 12, false},
+		{"tx.height > 2 AND tx.height < 100", txindex.SearchOptions{}, txResults[3:9], 6, false},
+		{"tx.height = 10", txindex.SearchOptions{OrderBy: txindex.OrderDesc},
+			[]*types.TxResult{txResults[8], txResults[7], txResults[6]}, 3, false},
+		{"tx.height <= 10 AND account.number > 1", txindex.SearchOptions{Limit: 2},
+			[]*types.TxResult{txResults[1], txResults[2]}, 6, true},
+		{"tx.height <= 10 AND account.number > 1", txindex.SearchOptions{Limit: 2, Cursor: txindex.EncodeCursor(9, 2)},
+			[]*types.TxResult{txResults[5], txResults[7]}, 6, true},
+		{"account.number = 10", txindex.SearchOptions{OrderBy: txindex.OrderDesc, Cursor: txindex.EncodeCursor(100, 10)},
+			[]*types.TxResult{txResults[8], txResults[5], txResults[2]}, 4, false},
+		{"account.number = 2 AND tx.height > 9", txindex.SearchOptions{},
+			[]*types.TxResult{txResults[7], txResults[10]}, 2, false},
+		{"account.number = 2 AND tx.height > 9", txindex.SearchOptions{Limit: 1},
+			[]*types.TxResult{txResults[7]}, 2, true},
+		{"tx.height > 100", txindex.SearchOptions{}, []*types.TxResult{}, 0, false},
+		{"tx.height < 9 AND tx.height > 9", txindex.SearchOptions{}, []*types.TxResult{}, 0, false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			res, err := indexer.SearchPage(query.MustParse(tc.q), tc.opts)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, res.Txs)
+			assert.Equal(t, tc.total, res.TotalCount)
+			assert.Equal(t, tc.hasNext, res.NextCursor != "")
+		})
+	}
+}
+
+func TestTxIndexMigratePositions(t *testing.T) {
+	store := db.NewMemDB()
+
+	// store txs without the position index, as before it existed
+	txResult1 := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []kv.Pair{{Key: []byte("number"), Value: []byte("1")}}},
+	})
+	txResult2 := txResultWithEvents(nil)
+	txResult2.Tx = types.Tx("HELLO WORLD 2")
+	txResult2.Height = 2
+	for _, txResult := range []*types.TxResult{txResult2, txResult1} {
+		rawBytes, err := cdc.MarshalBinaryBare(txResult)
+		require.NoError(t, err)
+		store.Set(txResult.Tx.Hash(), rawBytes)
+		store.Set(keyForEvent("account.number", []byte("1"), txResult), txResult.Tx.Hash())
+	}
+
+	// the equality conditions don't need the position index
+	indexer := NewTxIndex(store, IndexAllEvents())
+	results, err := indexer.Search(query.MustParse("account.number = 1"))
+	require.NoError(t, err)
+	assert.Equal(t, []*types.TxResult{txResult1, txResult2}, results)
+	migrated, err := store.Has([]byte(txPositionMigratedKey))
+	require.NoError(t, err)
+	assert.False(t, migrated)
+
+	// the range queries walk it, once it's built
+	results, err = indexer.Search(query.MustParse("tx.height > 0"))
+	require.NoError(t, err)
+	assert.Equal(t, []*types.TxResult{txResult1, txResult2}, results)
+
+	// the migration only runs once
+	store.Delete(keyForPosition(txResult2.Height, txResult2.Index))
+	indexer = NewTxIndex(store, IndexAllEvents())
+	results, err = indexer.Search(query.MustParse("tx.height > 0"))
+	require.NoError(t, err)
+	assert.Equal(t, []*types.TxResult{txResult1}, results)
+}
+
 func TestIndexAllTags(t *testing.T) {
 	indexer := NewTxIndex(db.NewMemDB(), IndexAllEvents())
 
@@ -0,0 +1,94 @@
+package txindex
+
+import (
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/types"
+)
+
+var (
+	_ TxIndexer    = (*MultiTxIndex)(nil)
+	_ BlockIndexer = (*MultiBlockIndex)(nil)
+)
+
+// MultiTxIndex writes txs to several indexers (sinks) at once. Lookups and
+// searches are served by the first one.
+type MultiTxIndex struct {
+	indexers []TxIndexer
+}
+
+// NewMultiTxIndex creates a MultiTxIndex from one or more indexers.
+func NewMultiTxIndex(indexers ...TxIndexer) *MultiTxIndex {
+	if len(indexers) == 0 {
+		panic("at least one indexer is required")
+	}
+	return &MultiTxIndex{indexers: indexers}
+}
+
+// AddBatch adds the batch to every indexer, and stops at the first error.
+func (txi *MultiTxIndex) AddBatch(b *Batch) error {
+	for _, indexer := range txi.indexers {
+		if err := indexer.AddBatch(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Index indexes the tx with every indexer, and stops at the first error.
+func (txi *MultiTxIndex) Index(result *types.TxResult) error {
+	for _, indexer := range txi.indexers {
+		if err := indexer.Index(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns the tx from the first indexer.
+func (txi *MultiTxIndex) Get(hash []byte) (*types.TxResult, error) {
+	return txi.indexers[0].Get(hash)
+}
+
+// Search searches the first indexer.
+func (txi *MultiTxIndex) Search(q *query.Query) ([]*types.TxResult, error) {
+	return txi.indexers[0].Search(q)
+}
+
+// SearchPage searches the first indexer.
+func (txi *MultiTxIndex) SearchPage(q *query.Query, opts SearchOptions) (*SearchResult, error) {
+	return txi.indexers[0].SearchPage(q, opts)
+}
+
+// MultiBlockIndex writes blocks to several indexers (sinks) at once. Lookups
+// and searches are served by the first one.
+type MultiBlockIndex struct {
+	indexers []BlockIndexer
+}
+
+// NewMultiBlockIndex creates a MultiBlockIndex from one or more indexers.
+func NewMultiBlockIndex(indexers ...BlockIndexer) *MultiBlockIndex {
+	if len(indexers) == 0 {
+		panic("at least one indexer is required")
+	}
+	return &MultiBlockIndex{indexers: indexers}
+}
+
+// Has returns true if the first indexer has indexed the block.
+func (bi *MultiBlockIndex) Has(height int64) (bool, error) {
+	return bi.indexers[0].Has(height)
+}
+
+// Index indexes the block with every indexer, and stops at the first error.
+func (bi *MultiBlockIndex) Index(header types.EventDataNewBlockHeader) error {
+	for _, indexer := range bi.indexers {
+		if err := indexer.Index(header); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Search searches the first indexer.
+func (bi *MultiBlockIndex) Search(q *query.Query) ([]int64, error) {
+	return bi.indexers[0].Search(q)
+}
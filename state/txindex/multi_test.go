@@ -0,0 +1,59 @@
+package txindex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/state/txindex/kv"
+	"github.com/tendermint/tendermint/types"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestMultiTxIndex(t *testing.T) {
+	first := kv.NewTxIndex(db.NewMemDB(), kv.IndexAllEvents())
+	second := kv.NewTxIndex(db.NewMemDB(), kv.IndexAllEvents())
+	indexer := txindex.NewMultiTxIndex(first, second)
+
+	txResult := &types.TxResult{
+		Height: 1,
+		Index:  0,
+		Tx:     types.Tx("foo"),
+		Result: abci.ResponseDeliverTx{Code: 0},
+	}
+	require.NoError(t, indexer.Index(txResult))
+
+	// every indexer has the tx
+	for _, idx := range []txindex.TxIndexer{indexer, first, second} {
+		res, err := idx.Get(txResult.Tx.Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, res)
+	}
+
+	results, err := indexer.Search(query.MustParse("tx.height = 1"))
+	require.NoError(t, err)
+	assert.Equal(t, []*types.TxResult{txResult}, results)
+}
+
+func TestMultiBlockIndex(t *testing.T) {
+	first := kv.NewBlockIndex(db.NewMemDB())
+	second := kv.NewBlockIndex(db.NewMemDB())
+	indexer := txindex.NewMultiBlockIndex(first, second)
+
+	require.NoError(t, indexer.Index(types.EventDataNewBlockHeader{Header: types.Header{Height: 1}}))
+
+	// every indexer has the block
+	for _, idx := range []txindex.BlockIndexer{indexer, first, second} {
+		ok, err := idx.Has(1)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	}
+
+	heights, err := indexer.Search(query.MustParse("block.height = 1"))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, heights)
+}
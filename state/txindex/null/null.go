@@ -31,3 +31,26 @@ func (txi *TxIndex) Index(result *types.TxResult) error {
 func (txi *TxIndex) Search(q *query.Query) ([]*types.TxResult, error) {
 	return []*types.TxResult{}, nil
 }
+
+func (txi *TxIndex) SearchPage(q *query.Query, opts txindex.SearchOptions) (*txindex.SearchResult, error) {
+	return &txindex.SearchResult{Txs: []*types.TxResult{}}, nil
+}
+
+var _ txindex.BlockIndexer = (*BlockIndex)(nil)
+
+// BlockIndex acts as a /dev/null.
+type BlockIndex struct{}
+
+// Has is a noop and always returns false.
+func (bi *BlockIndex) Has(height int64) (bool, error) {
+	return false, nil
+}
+
+// Index is a noop and always returns nil.
+func (bi *BlockIndex) Index(header types.EventDataNewBlockHeader) error {
+	return nil
+}
+
+func (bi *BlockIndex) Search(q *query.Query) ([]int64, error) {
+	return []int64{}, nil
+}
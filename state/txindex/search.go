@@ -0,0 +1,98 @@
+package txindex
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// OrderAsc orders the search results by ascending height & index.
+	OrderAsc = "asc"
+	// OrderDesc orders the search results by descending height & index.
+	OrderDesc = "desc"
+)
+
+// SearchOptions narrows down the results of a search to a single page.
+type SearchOptions struct {
+	// OrderBy orders the results by height & index, either ascending (OrderAsc,
+	// the default if empty) or descending (OrderDesc).
+	OrderBy string
+
+	// Cursor continues a previous search (with the same query and order) after
+	// the result it points to. An empty cursor starts from the first result.
+	Cursor string
+
+	// Limit is the maximum number of results to return, or 0 for no limit.
+	Limit int
+}
+
+// ValidateBasic performs basic validation.
+func (opts SearchOptions) ValidateBasic() error {
+	switch opts.OrderBy {
+	case "", OrderAsc, OrderDesc:
+	default:
+		return fmt.Errorf("order_by must be either %q or %q, got %q", OrderAsc, OrderDesc, opts.OrderBy)
+	}
+	if opts.Limit < 0 {
+		return errors.New("limit can't be negative")
+	}
+	if opts.Cursor != "" {
+		if _, _, err := DecodeCursor(opts.Cursor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Descending returns true if the results are ordered by descending height &
+// index.
+func (opts SearchOptions) Descending() bool {
+	return opts.OrderBy == OrderDesc
+}
+
+// SearchResult is a single page of search results.
+type SearchResult struct {
+	// Txs are the matching txs after the cursor, at most Limit of them.
+	Txs []*types.TxResult
+
+	// TotalCount is the number of txs matching the query, regardless of the
+	// cursor and limit.
+	TotalCount int
+
+	// NextCursor continues the search after the last of the Txs, or is empty if
+	// there are no more results.
+	NextCursor string
+}
+
+// EncodeCursor returns the opaque cursor pointing to the tx at the given
+// height & index.
+func EncodeCursor(height int64, index uint32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d/%d", height, index)))
+}
+
+// DecodeCursor returns the height & index of the tx the cursor points to.
+func DecodeCursor(cursor string) (height int64, index uint32, err error) {
+	bz, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "invalid cursor")
+	}
+	parts := strings.Split(string(bz), "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	height, err = strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || height <= 0 {
+		return 0, 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	idx, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	return height, uint32(idx), nil
+}
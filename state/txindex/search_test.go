@@ -0,0 +1,49 @@
+package txindex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/state/txindex"
+)
+
+func TestCursor(t *testing.T) {
+	cursor := txindex.EncodeCursor(12, 3)
+	height, index, err := txindex.DecodeCursor(cursor)
+	require.NoError(t, err)
+	assert.EqualValues(t, 12, height)
+	assert.EqualValues(t, 3, index)
+
+	for _, invalid := range []string{"", "foo", txindex.EncodeCursor(0, 1), "MTI"} {
+		_, _, err := txindex.DecodeCursor(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSearchOptionsValidateBasic(t *testing.T) {
+	testCases := map[string]struct {
+		opts  txindex.SearchOptions
+		valid bool
+	}{
+		"empty":          {txindex.SearchOptions{}, true},
+		"asc":            {txindex.SearchOptions{OrderBy: txindex.OrderAsc, Limit: 10}, true},
+		"desc":           {txindex.SearchOptions{OrderBy: txindex.OrderDesc}, true},
+		"cursor":         {txindex.SearchOptions{Cursor: txindex.EncodeCursor(1, 0)}, true},
+		"invalid order":  {txindex.SearchOptions{OrderBy: "foo"}, false},
+		"invalid cursor": {txindex.SearchOptions{Cursor: "foo"}, false},
+		"negative limit": {txindex.SearchOptions{Limit: -1}, false},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.opts.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
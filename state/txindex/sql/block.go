@@ -0,0 +1,120 @@
+package sql
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+var _ txindex.BlockIndexer = (*BlockIndex)(nil)
+
+// BlockIndex indexes the heights of blocks and their BeginBlock and EndBlock
+// events (with a non-empty type) into the blocks, events and attributes tables
+// of a Store.
+type BlockIndex struct {
+	store *Store
+}
+
+// NewBlockIndex creates new SQL block indexer.
+func NewBlockIndex(store *Store) *BlockIndex {
+	return &BlockIndex{store: store}
+}
+
+// Has returns true if the block at the given height has been indexed.
+func (bi *BlockIndex) Has(height int64) (bool, error) {
+	if height <= 0 {
+		return false, fmt.Errorf("height must be greater than 0, got %d", height)
+	}
+
+	var n int
+	err := bi.store.db.QueryRow(bi.store.dialect.Rebind(`SELECT COUNT(*) FROM blocks WHERE height = ?`), height).Scan(&n)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Index indexes the BeginBlock and EndBlock events of a block by its height.
+// If the block was already indexed, its events are replaced.
+func (bi *BlockIndex) Index(header types.EventDataNewBlockHeader) error {
+	height := header.Header.Height
+	return bi.store.inTx(func(tx *sql.Tx) error {
+		// remove the previous events, if any
+		err := bi.store.exec(tx, `DELETE FROM attributes WHERE event_id IN (
+			SELECT id FROM events WHERE height = ? AND tx_hash IS NULL)`, height)
+		if err != nil {
+			return errors.Wrap(err, "failed to delete block attributes")
+		}
+		if err = bi.store.exec(tx, `DELETE FROM events WHERE height = ? AND tx_hash IS NULL`, height); err != nil {
+			return errors.Wrap(err, "failed to delete block events")
+		}
+		if err = bi.store.exec(tx, `DELETE FROM blocks WHERE height = ?`, height); err != nil {
+			return errors.Wrap(err, "failed to delete block")
+		}
+
+		if err = bi.store.exec(tx, `INSERT INTO blocks (height) VALUES (?)`, height); err != nil {
+			return errors.Wrap(err, "failed to insert block")
+		}
+		err = bi.store.insertEvents(tx, height, sql.NullString{}, sourceBeginBlock,
+			header.ResultBeginBlock.Events, types.BlockHeightKey)
+		if err != nil {
+			return err
+		}
+		return bi.store.insertEvents(tx, height, sql.NullString{}, sourceEndBlock,
+			header.ResultEndBlock.Events, types.BlockHeightKey)
+	})
+}
+
+// Search performs a search using the given query. Every condition (like
+// "block.height > 5") is translated to a SQL filter, and the matching heights
+// are returned in ascending order. Ranges are only supported for numbers.
+func (bi *BlockIndex) Search(q *query.Query) ([]int64, error) {
+	conditions, err := q.Conditions()
+	if err != nil {
+		return nil, errors.Wrap(err, "error during parsing conditions from query")
+	}
+
+	filters := make([]string, 0, len(conditions))
+	args := make([]interface{}, 0, len(conditions))
+	for _, c := range conditions {
+		var (
+			filter     string
+			filterArgs []interface{}
+		)
+		if c.CompositeKey == types.BlockHeightKey {
+			filter, filterArgs, err = columnFilter("height", c)
+		} else {
+			filter, filterArgs, err = bi.store.attributeFilter(c)
+			filter = `height IN (SELECT e.height FROM events e JOIN attributes a ON a.event_id = e.id
+				WHERE e.tx_hash IS NULL AND ` + filter + `)`
+		}
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+		args = append(args, filterArgs...)
+	}
+
+	rows, err := bi.store.db.Query(bi.store.dialect.Rebind(
+		`SELECT height FROM blocks`+whereClause(filters)+` ORDER BY height`), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]int64, 0)
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, err
+		}
+		results = append(results, height)
+	}
+
+	return results, rows.Err()
+}
@@ -0,0 +1,7 @@
+package sql
+
+import (
+	amino "github.com/tendermint/go-amino"
+)
+
+var cdc = amino.NewCodec()
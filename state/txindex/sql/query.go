@@ -0,0 +1,81 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+)
+
+// operators maps the comparison operators of a query to SQL.
+var operators = map[query.Operator]string{
+	query.OpLessEqual:    "<=",
+	query.OpGreaterEqual: ">=",
+	query.OpLess:         "<",
+	query.OpGreater:      ">",
+	query.OpEqual:        "=",
+}
+
+// columnFilter returns the SQL filter for a condition on an integer column,
+// like the height.
+func columnFilter(column string, c query.Condition) (string, []interface{}, error) {
+	op, ok := operators[c.Op]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported operator for %s", c.CompositeKey)
+	}
+	if _, ok := c.Operand.(int64); !ok {
+		return "", nil, fmt.Errorf("%s must be compared to an integer, got %v", c.CompositeKey, c.Operand)
+	}
+	return fmt.Sprintf("%s %s ?", column, op), []interface{}{c.Operand}, nil
+}
+
+// attributeFilter returns the SQL filter for a condition on an event
+// attribute, from the attributes table aliased as "a". Ranges are only
+// supported for numbers, and times and dates never match.
+func (s *Store) attributeFilter(c query.Condition) (string, []interface{}, error) {
+	switch c.Op {
+	case query.OpExists:
+		return "a.composite_key = ?", []interface{}{c.CompositeKey}, nil
+
+	case query.OpContains:
+		operand, ok := c.Operand.(string)
+		if !ok {
+			return "", nil, fmt.Errorf("CONTAINS requires a string, got %v", c.Operand)
+		}
+		filter := fmt.Sprintf("a.composite_key = ? AND %s(a.attr_value, ?) > 0", s.dialect.ContainsFunc)
+		return filter, []interface{}{c.CompositeKey, operand}, nil
+
+	case query.OpEqual:
+		switch operand := c.Operand.(type) {
+		case string:
+			return "a.composite_key = ? AND a.attr_value = ?", []interface{}{c.CompositeKey, operand}, nil
+		case int64:
+			return "a.composite_key = ? AND a.int_value = ?", []interface{}{c.CompositeKey, operand}, nil
+		case float64:
+			return "a.composite_key = ? AND a.attr_value = ?", []interface{}{c.CompositeKey, fmt.Sprintf("%v", operand)}, nil
+		}
+
+	default:
+		op, ok := operators[c.Op]
+		if !ok {
+			return "", nil, fmt.Errorf("unsupported operator for %s", c.CompositeKey)
+		}
+		switch c.Operand.(type) {
+		case int64, float64:
+			return fmt.Sprintf("a.composite_key = ? AND a.int_value %s ?", op), []interface{}{c.CompositeKey, c.Operand}, nil
+		}
+	}
+
+	// XXX: passing time in a ABCI Events is not yet implemented, so nothing
+	// can match
+	return "1 = 0", nil, nil
+}
+
+// whereClause joins the filters into a WHERE clause, or returns an empty
+// string if there are none.
+func whereClause(filters []string) string {
+	if len(filters) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(filters, " AND ")
+}
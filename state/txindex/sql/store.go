@@ -0,0 +1,193 @@
+package sql
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/sqldialect"
+)
+
+const (
+	// event sources, used to tell the events of a block or tx apart
+	sourceBeginBlock = "begin_block"
+	sourceEndBlock   = "end_block"
+	sourceDeliverTx  = "deliver_tx"
+)
+
+// Store is a SQL database, accessed through database/sql, to which TxIndex and
+// BlockIndex write the blocks, txs and their events in normalized tables:
+//
+//  - blocks: the heights of the indexed blocks
+//  - tx_results: the indexed txs and their results, by hash
+//  - events: the events of the blocks (tx_hash is NULL) and txs
+//  - attributes: the key-value pairs of the events
+//
+// The tables are joined by height, tx_hash and event_id, so that they can be
+// used for analytics outside of Tendermint.
+type Store struct {
+	db      *sql.DB
+	dialect sqldialect.Dialect
+}
+
+// Open opens the database with the given driver ("sqlite3" or "postgres") and
+// data source name, and creates the tables if they don't exist yet. The driver
+// must be registered by the caller.
+func Open(driverName, dataSourceName string) (*Store, error) {
+	if _, err := sqldialect.ForDriver(driverName); err != nil {
+		return nil, err
+	}
+	db, err := sql.Open(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	s, err := NewStore(db, driverName)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// NewStore creates a Store from an open database of the given driver
+// ("sqlite3" or "postgres"), and creates the tables if they don't exist yet.
+func NewStore(db *sql.DB, driverName string) (*Store, error) {
+	d, err := sqldialect.ForDriver(driverName)
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{db: db, dialect: d}
+	if err := s.createTables(); err != nil {
+		return nil, errors.Wrap(err, "failed to create tables")
+	}
+	return s, nil
+}
+
+// Close closes the database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func (s *Store) createTables() error {
+	stmts := []string{
+		`CREATE TABLE IF NOT EXISTS blocks (
+			height BIGINT NOT NULL PRIMARY KEY
+		)`,
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS tx_results (
+			hash VARCHAR(64) NOT NULL PRIMARY KEY,
+			height BIGINT NOT NULL,
+			tx_index INTEGER NOT NULL,
+			tx_result %s NOT NULL
+		)`, s.dialect.BlobType),
+		`CREATE INDEX IF NOT EXISTS tx_results_height ON tx_results (height, tx_index)`,
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS events (
+			id %s,
+			height BIGINT NOT NULL,
+			tx_hash VARCHAR(64),
+			source VARCHAR(16) NOT NULL,
+			event_type TEXT NOT NULL
+		)`, s.dialect.IDType),
+		`CREATE INDEX IF NOT EXISTS events_height ON events (height)`,
+		`CREATE INDEX IF NOT EXISTS events_tx_hash ON events (tx_hash)`,
+		`CREATE TABLE IF NOT EXISTS attributes (
+			event_id BIGINT NOT NULL REFERENCES events (id),
+			attr_key TEXT NOT NULL,
+			composite_key TEXT NOT NULL,
+			attr_value TEXT NOT NULL,
+			int_value BIGINT
+		)`,
+		`CREATE INDEX IF NOT EXISTS attributes_event_id ON attributes (event_id)`,
+		`CREATE INDEX IF NOT EXISTS attributes_composite_key ON attributes (composite_key, attr_value)`,
+	}
+	for _, stmt := range stmts {
+		if _, err := s.db.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inTx runs fn in a database transaction, which is committed if fn succeeds
+// and rolled back otherwise.
+func (s *Store) inTx(fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback() // nolint: errcheck
+		return err
+	}
+	return tx.Commit()
+}
+
+// exec executes a statement within the given transaction.
+func (s *Store) exec(tx *sql.Tx, query string, args ...interface{}) error {
+	_, err := tx.Exec(s.dialect.Rebind(query), args...)
+	return err
+}
+
+// insertEvents inserts the events of a block (txHash is NULL) or tx, and their
+// attributes. Events with an empty type, attributes with an empty key and
+// attributes with a reserved composite key are skipped.
+func (s *Store) insertEvents(
+	tx *sql.Tx,
+	height int64,
+	txHash sql.NullString,
+	source string,
+	events []abci.Event,
+	reservedKey string,
+) error {
+	for _, event := range events {
+		// only index events with a non-empty type
+		if len(event.Type) == 0 {
+			continue
+		}
+
+		eventID, err := s.insertEvent(tx, height, txHash, source, event.Type)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert event")
+		}
+
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+
+			compositeKey := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
+			if compositeKey == reservedKey {
+				continue
+			}
+
+			// numeric values are also stored as integers, for range queries
+			var intValue sql.NullInt64
+			if v, err := strconv.ParseInt(string(attr.Value), 10, 64); err == nil {
+				intValue = sql.NullInt64{Int64: v, Valid: true}
+			}
+
+			err := s.exec(tx, `INSERT INTO attributes (event_id, attr_key, composite_key, attr_value, int_value)
+				VALUES (?, ?, ?, ?, ?)`, eventID, string(attr.Key), compositeKey, string(attr.Value), intValue)
+			if err != nil {
+				return errors.Wrap(err, "failed to insert event attribute")
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Store) insertEvent(tx *sql.Tx, height int64, txHash sql.NullString, source, eventType string) (int64, error) {
+	query := `INSERT INTO events (height, tx_hash, source, event_type) VALUES (?, ?, ?, ?)`
+	if s.dialect.ReturningID {
+		var id int64
+		err := tx.QueryRow(s.dialect.Rebind(query+" RETURNING id"), height, txHash, source, eventType).Scan(&id)
+		return id, err
+	}
+	res, err := tx.Exec(s.dialect.Rebind(query), height, txHash, source, eventType)
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
@@ -0,0 +1,230 @@
+package sql
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+var _ txindex.TxIndexer = (*TxIndex)(nil)
+
+// TxIndex indexes txs and all their events (with a non-empty type) into the
+// tx_results, events and attributes tables of a Store.
+type TxIndex struct {
+	store *Store
+}
+
+// NewTxIndex creates new SQL indexer.
+func NewTxIndex(store *Store) *TxIndex {
+	return &TxIndex{store: store}
+}
+
+// Get gets transaction from the SQL database and returns it or nil if the
+// transaction is not found.
+func (txi *TxIndex) Get(hash []byte) (*types.TxResult, error) {
+	if len(hash) == 0 {
+		return nil, txindex.ErrorEmptyHash
+	}
+
+	var rawBytes []byte
+	err := txi.store.db.QueryRow(txi.store.dialect.Rebind(`SELECT tx_result FROM tx_results WHERE hash = ?`),
+		fmt.Sprintf("%X", hash)).Scan(&rawBytes)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return decodeTxResult(rawBytes)
+}
+
+// AddBatch indexes a batch of transactions in a single database transaction.
+func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
+	return txi.store.inTx(func(tx *sql.Tx) error {
+		for _, result := range b.Ops {
+			if err := txi.index(tx, result); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Index indexes a single transaction. If the transaction was already indexed,
+// it is replaced.
+func (txi *TxIndex) Index(result *types.TxResult) error {
+	return txi.store.inTx(func(tx *sql.Tx) error {
+		return txi.index(tx, result)
+	})
+}
+
+func (txi *TxIndex) index(tx *sql.Tx, result *types.TxResult) error {
+	hash := fmt.Sprintf("%X", result.Tx.Hash())
+
+	rawBytes, err := cdc.MarshalBinaryBare(result)
+	if err != nil {
+		return err
+	}
+
+	// remove the previous result, if any
+	err = txi.store.exec(tx, `DELETE FROM attributes WHERE event_id IN (
+		SELECT id FROM events WHERE tx_hash = ?)`, hash)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete tx attributes")
+	}
+	if err = txi.store.exec(tx, `DELETE FROM events WHERE tx_hash = ?`, hash); err != nil {
+		return errors.Wrap(err, "failed to delete tx events")
+	}
+	if err = txi.store.exec(tx, `DELETE FROM tx_results WHERE hash = ?`, hash); err != nil {
+		return errors.Wrap(err, "failed to delete tx result")
+	}
+
+	err = txi.store.exec(tx, `INSERT INTO tx_results (hash, height, tx_index, tx_result) VALUES (?, ?, ?, ?)`,
+		hash, result.Height, result.Index, rawBytes)
+	if err != nil {
+		return errors.Wrap(err, "failed to insert tx result")
+	}
+
+	return txi.store.insertEvents(tx, result.Height, sql.NullString{String: hash, Valid: true},
+		sourceDeliverTx, result.Result.Events, "")
+}
+
+// Search performs a search using the given query, and returns all the
+// matching txs sorted by height & index. See SearchPage.
+func (txi *TxIndex) Search(q *query.Query) ([]*types.TxResult, error) {
+	res, err := txi.SearchPage(q, txindex.SearchOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Txs, nil
+}
+
+// SearchPage performs a search using the given query. Every condition (like
+// "tx.height > 5") is translated to a SQL filter, and the ordering, cursor and
+// limit are pushed down to the database. Ranges are only supported for
+// numbers.
+func (txi *TxIndex) SearchPage(q *query.Query, opts txindex.SearchOptions) (*txindex.SearchResult, error) {
+	if err := opts.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	filters, args, err := txi.filters(q)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalCount int
+	err = txi.store.db.QueryRow(txi.store.dialect.Rebind(
+		`SELECT COUNT(*) FROM tx_results`+whereClause(filters)), args...).Scan(&totalCount)
+	if err != nil {
+		return nil, err
+	}
+
+	order, cmp := "ASC", ">"
+	if opts.Descending() {
+		order, cmp = "DESC", "<"
+	}
+	if opts.Cursor != "" {
+		height, index, _ := txindex.DecodeCursor(opts.Cursor)
+		filters = append(filters, fmt.Sprintf("(height %s ? OR (height = ? AND tx_index %s ?))", cmp, cmp))
+		args = append(args, height, height, index)
+	}
+	stmt := `SELECT tx_result FROM tx_results` + whereClause(filters) +
+		fmt.Sprintf(` ORDER BY height %s, tx_index %s`, order, order)
+	if opts.Limit > 0 {
+		// fetch one more tx, to tell whether there are more results
+		stmt += ` LIMIT ?`
+		args = append(args, opts.Limit+1)
+	}
+
+	rows, err := txi.store.db.Query(txi.store.dialect.Rebind(stmt), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]*types.TxResult, 0)
+	for rows.Next() {
+		var rawBytes []byte
+		if err := rows.Scan(&rawBytes); err != nil {
+			return nil, err
+		}
+		result, err := decodeTxResult(rawBytes)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if opts.Limit > 0 && len(results) > opts.Limit {
+		results = results[:opts.Limit]
+		last := results[len(results)-1]
+		nextCursor = txindex.EncodeCursor(last.Height, last.Index)
+	}
+
+	return &txindex.SearchResult{
+		Txs:        results,
+		TotalCount: totalCount,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// filters translates the conditions of the query to SQL filters on the
+// tx_results table.
+func (txi *TxIndex) filters(q *query.Query) ([]string, []interface{}, error) {
+	conditions, err := q.Conditions()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error during parsing conditions from query")
+	}
+
+	filters := make([]string, 0, len(conditions))
+	args := make([]interface{}, 0, len(conditions))
+	for _, c := range conditions {
+		var (
+			filter     string
+			filterArgs []interface{}
+		)
+		switch c.CompositeKey {
+		case types.TxHashKey:
+			hash, ok := c.Operand.(string)
+			if c.Op != query.OpEqual || !ok {
+				return nil, nil, fmt.Errorf("%s can only be compared with = to a string", types.TxHashKey)
+			}
+			filter, filterArgs = "hash = ?", []interface{}{strings.ToUpper(hash)}
+
+		case types.TxHeightKey:
+			filter, filterArgs, err = columnFilter("height", c)
+
+		default:
+			filter, filterArgs, err = txi.store.attributeFilter(c)
+			filter = `hash IN (SELECT e.tx_hash FROM events e JOIN attributes a ON a.event_id = e.id
+				WHERE e.tx_hash IS NOT NULL AND ` + filter + `)`
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		filters = append(filters, filter)
+		args = append(args, filterArgs...)
+	}
+
+	return filters, args, nil
+}
+
+func decodeTxResult(rawBytes []byte) (*types.TxResult, error) {
+	txResult := new(types.TxResult)
+	err := cdc.UnmarshalBinaryBare(rawBytes, &txResult)
+	if err != nil {
+		return nil, fmt.Errorf("error reading TxResult: %v", err)
+	}
+	return txResult, nil
+}
@@ -0,0 +1,320 @@
+package sql
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+// newTestStore opens a Store backed by a temporary SQLite database.
+func newTestStore(t *testing.T) (*Store, func()) {
+	dir, err := ioutil.TempDir("", "sql_tx_index")
+	require.NoError(t, err)
+
+	store, err := Open("sqlite3", filepath.Join(dir, "index.db"))
+	require.NoError(t, err)
+
+	return store, func() {
+		store.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestOpen_unsupportedDriver(t *testing.T) {
+	_, err := Open("mysql", "")
+	assert.Error(t, err)
+}
+
+func TestOpen_existingTables(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+
+	// creating the tables again is a no-op
+	err := store.createTables()
+	assert.NoError(t, err)
+}
+
+func TestTxIndex(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+	indexer := NewTxIndex(store)
+
+	txResult := txResultWithEvents(nil)
+	hash := txResult.Tx.Hash()
+
+	batch := txindex.NewBatch(1)
+	require.NoError(t, batch.Add(txResult))
+	err := indexer.AddBatch(batch)
+	require.NoError(t, err)
+
+	loadedTxResult, err := indexer.Get(hash)
+	require.NoError(t, err)
+	assert.Equal(t, txResult, loadedTxResult)
+
+	txResult2 := txResultWithEvents(nil)
+	txResult2.Tx = types.Tx("BYE BYE WORLD")
+	hash2 := txResult2.Tx.Hash()
+
+	err = indexer.Index(txResult2)
+	require.NoError(t, err)
+
+	loadedTxResult2, err := indexer.Get(hash2)
+	require.NoError(t, err)
+	assert.Equal(t, txResult2, loadedTxResult2)
+
+	// missing txs are nil
+	loadedTxResult, err = indexer.Get(types.Tx("missing").Hash())
+	require.NoError(t, err)
+	assert.Nil(t, loadedTxResult)
+
+	_, err = indexer.Get(nil)
+	assert.Equal(t, txindex.ErrorEmptyHash, err)
+}
+
+func TestTxIndex_reindex(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+	indexer := NewTxIndex(store)
+
+	txResult := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []kv.Pair{{Key: []byte("owner"), Value: []byte("Ivan")}}},
+	})
+	require.NoError(t, indexer.Index(txResult))
+
+	// indexing the same tx again replaces its events
+	txResult = txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []kv.Pair{{Key: []byte("owner"), Value: []byte("Vlad")}}},
+	})
+	require.NoError(t, indexer.Index(txResult))
+
+	results, err := indexer.Search(query.MustParse("account.owner = 'Ivan'"))
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	results, err = indexer.Search(query.MustParse("account.owner = 'Vlad'"))
+	require.NoError(t, err)
+	assert.Equal(t, []*types.TxResult{txResult}, results)
+}
+
+func TestTxSearch(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+	indexer := NewTxIndex(store)
+
+	txResult := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []kv.Pair{{Key: []byte("number"), Value: []byte("1")}}},
+		{Type: "account", Attributes: []kv.Pair{{Key: []byte("owner"), Value: []byte("Ivan")}}},
+		{Type: "", Attributes: []kv.Pair{{Key: []byte("not_allowed"), Value: []byte("Vlad")}}},
+	})
+	hash := txResult.Tx.Hash()
+
+	err := indexer.Index(txResult)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		q             string
+		resultsLength int
+	}{
+		// search by hash
+		{fmt.Sprintf("tx.hash = '%X'", hash), 1},
+		// search by hash (lowercase)
+		{fmt.Sprintf("tx.hash = '%x'", hash), 1},
+		// search by height
+		{"tx.height = 1", 1},
+		{"tx.height > 1", 0},
+		// search by exact match (one key)
+		{"account.number = 1", 1},
+		// search by exact match (two keys)
+		{"account.number = 1 AND account.owner = 'Ivan'", 1},
+		// search by exact match (two keys)
+		{"account.number = 1 AND account.owner = 'Vlad'", 0},
+		{"account.owner = 'Vlad' AND account.number = 1", 0},
+		{"account.number >= 1 AND account.owner = 'Vlad'", 0},
+		{"account.owner = 'Vlad' AND account.number >= 1", 0},
+		{"account.number <= 0", 0},
+		{"account.number <= 0 AND account.owner = 'Ivan'", 0},
+		// search using a prefix of the stored value
+		{"account.owner = 'Iv'", 0},
+		// search by range
+		{"account.number >= 1 AND account.number <= 5", 1},
+		// search by range (lower bound)
+		{"account.number >= 1", 1},
+		// search by range (upper bound)
+		{"account.number <= 5", 1},
+		// search using not allowed key
+		{"not_allowed = 'boom'", 0},
+		// search for not existing tx result
+		{"account.number >= 2 AND account.number <= 5", 0},
+		// search using not existing key
+		{"account.date >= TIME 2013-05-03T14:45:00Z", 0},
+		// search using CONTAINS
+		{"account.owner CONTAINS 'an'", 1},
+		// search using CONTAINS is case-sensitive
+		{"account.owner CONTAINS 'AN'", 0},
+		// search for non existing value using CONTAINS
+		{"account.owner CONTAINS 'Vlad'", 0},
+		// search using the wrong key (of numeric type) using CONTAINS
+		{"account.number CONTAINS 'Iv'", 0},
+		// search using EXISTS
+		{"account.owner EXISTS", 1},
+		{"account.date EXISTS", 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := indexer.Search(query.MustParse(tc.q))
+			assert.NoError(t, err)
+
+			assert.Len(t, results, tc.resultsLength)
+			if tc.resultsLength > 0 {
+				assert.Equal(t, []*types.TxResult{txResult}, results)
+			}
+		})
+	}
+}
+
+func TestTxSearchOrder(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+	indexer := NewTxIndex(store)
+
+	var txResults []*types.TxResult
+	for _, h := range []int64{3, 1, 2} {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []kv.Pair{{Key: []byte("number"), Value: []byte("1")}}},
+		})
+		txResult.Tx = types.Tx(fmt.Sprintf("tx %d", h))
+		txResult.Height = h
+		require.NoError(t, indexer.Index(txResult))
+		txResults = append(txResults, txResult)
+	}
+
+	results, err := indexer.Search(query.MustParse("account.number = 1"))
+	require.NoError(t, err)
+	assert.Equal(t, []*types.TxResult{txResults[1], txResults[2], txResults[0]}, results)
+}
+
+func TestTxSearchPage(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+	indexer := NewTxIndex(store)
+
+	// index 6 txs: 2 per height, at heights 1 to 3
+	var txResults []*types.TxResult
+	for height := int64(1); height <= 3; height++ {
+		for index := uint32(0); index < 2; index++ {
+			txResult := txResultWithEvents([]abci.Event{
+				{Type: "account", Attributes: []kv.Pair{{Key: []byte("number"), Value: []byte("1")}}},
+			})
+			txResult.Tx = types.Tx(fmt.Sprintf("tx %d %d", height, index))
+			txResult.Height = height
+			txResult.Index = index
+			txResults = append(txResults, txResult)
+		}
+	}
+	// index them out of order
+	for _, i := range []int{3, 0, 5, 1, 4, 2} {
+		require.NoError(t, indexer.Index(txResults[i]))
+	}
+	reversed := make([]*types.TxResult, len(txResults))
+	for i, txResult := range txResults {
+		reversed[len(txResults)-1-i] = txResult
+	}
+
+	q := query.MustParse("account.number = 1")
+
+	testCases := map[string]struct {
+		orderBy string
+		limit   int
+		expect  []*types.TxResult
+	}{
+		"no limit":         {"", 0, txResults},
+		"asc no limit":     {txindex.OrderAsc, 0, txResults},
+		"desc no limit":    {txindex.OrderDesc, 0, reversed},
+		"asc limit 4":      {txindex.OrderAsc, 4, txResults},
+		"desc limit 4":     {txindex.OrderDesc, 4, reversed},
+		"asc limit 3":      {txindex.OrderAsc, 3, txResults},
+		"desc limit 1":     {txindex.OrderDesc, 1, reversed},
+		"asc limit larger": {txindex.OrderAsc, 10, txResults},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			// follow the cursors until there are no more results
+			opts := txindex.SearchOptions{OrderBy: tc.orderBy, Limit: tc.limit}
+			results := make([]*types.TxResult, 0)
+			for {
+				res, err := indexer.SearchPage(q, opts)
+				require.NoError(t, err)
+				assert.Equal(t, len(txResults), res.TotalCount)
+				if tc.limit > 0 {
+					assert.LessOrEqual(t, len(res.Txs), tc.limit)
+				}
+				results = append(results, res.Txs...)
+				if res.NextCursor == "" {
+					break
+				}
+				opts.Cursor = res.NextCursor
+			}
+			assert.Equal(t, tc.expect, results)
+		})
+	}
+
+	// no matches
+	res, err := indexer.SearchPage(query.MustParse("account.number = 2"), txindex.SearchOptions{Limit: 1})
+	require.NoError(t, err)
+	assert.Empty(t, res.Txs)
+	assert.Zero(t, res.TotalCount)
+	assert.Empty(t, res.NextCursor)
+
+	// invalid options
+	_, err = indexer.SearchPage(q, txindex.SearchOptions{OrderBy: "foo"})
+	assert.Error(t, err)
+	_, err = indexer.SearchPage(q, txindex.SearchOptions{Cursor: "foo"})
+	assert.Error(t, err)
+	_, err = indexer.SearchPage(q, txindex.SearchOptions{Limit: -1})
+	assert.Error(t, err)
+}
+
+func TestTxSearch_invalidConditions(t *testing.T) {
+	store, cleanup := newTestStore(t)
+	defer cleanup()
+	indexer := NewTxIndex(store)
+
+	for _, q := range []string{
+		"tx.hash CONTAINS 'ABCD'",
+		"tx.height = 'one'",
+		"tx.height CONTAINS '1'",
+	} {
+		_, err := indexer.Search(query.MustParse(q))
+		assert.Error(t, err, q)
+	}
+}
+
+func txResultWithEvents(events []abci.Event) *types.TxResult {
+	tx := types.Tx("HELLO WORLD")
+	return &types.TxResult{
+		Height: 1,
+		Index:  0,
+		Tx:     tx,
+		Result: abci.ResponseDeliverTx{
+			Data:   []byte{0},
+			Code:   abci.CodeTypeOK,
+			Log:    "",
+			Events: events,
+		},
+	}
+}
@@ -0,0 +1,86 @@
+package statesync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotsRequestMessage_ValidateBasic(t *testing.T) {
+	assert.NoError(t, (&snapshotsRequestMessage{}).ValidateBasic())
+}
+
+func TestSnapshotsResponseMessage_ValidateBasic(t *testing.T) {
+	testcases := map[string]struct {
+		msg   *snapshotsResponseMessage
+		valid bool
+	}{
+		"valid":     {&snapshotsResponseMessage{Height: 1, Format: 1, Chunks: 2, Hash: []byte{1}}, true},
+		"no height": {&snapshotsResponseMessage{Height: 0, Format: 1, Chunks: 2, Hash: []byte{1}}, false},
+		"no chunks": {&snapshotsResponseMessage{Height: 1, Format: 1, Chunks: 0, Hash: []byte{1}}, false},
+		"no hash":   {&snapshotsResponseMessage{Height: 1, Format: 1, Chunks: 2, Hash: []byte{}}, false},
+		"no format": {&snapshotsResponseMessage{Height: 1, Format: 0, Chunks: 2, Hash: []byte{1}}, true},
+		"with metadata": {&snapshotsResponseMessage{
+			Height: 1, Format: 1, Chunks: 2, Hash: []byte{1}, Metadata: []byte{2}}, true},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestChunkRequestMessage_ValidateBasic(t *testing.T) {
+	assert.NoError(t, (&chunkRequestMessage{Height: 1, Format: 1, Index: 0}).ValidateBasic())
+	assert.Error(t, (&chunkRequestMessage{Height: 0, Format: 1, Index: 0}).ValidateBasic())
+}
+
+func TestChunkResponseMessage_ValidateBasic(t *testing.T) {
+	testcases := map[string]struct {
+		msg   *chunkResponseMessage
+		valid bool
+	}{
+		"valid":              {&chunkResponseMessage{Height: 1, Format: 1, Index: 1, Chunk: []byte{1}}, true},
+		"empty chunk":        {&chunkResponseMessage{Height: 1, Format: 1, Index: 1, Chunk: []byte{}}, true},
+		"nil chunk":          {&chunkResponseMessage{Height: 1, Format: 1, Index: 1}, false},
+		"no height":          {&chunkResponseMessage{Height: 0, Format: 1, Index: 1, Chunk: []byte{1}}, false},
+		"missing":            {&chunkResponseMessage{Height: 1, Format: 1, Index: 1, Missing: true}, true},
+		"missing with chunk": {&chunkResponseMessage{Height: 1, Format: 1, Index: 1, Chunk: []byte{1}, Missing: true}, false},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDecodeMsg(t *testing.T) {
+	msgs := []Message{
+		&snapshotsRequestMessage{},
+		&snapshotsResponseMessage{Height: 1, Format: 2, Chunks: 3, Hash: []byte{4}, Metadata: []byte{5}},
+		&chunkRequestMessage{Height: 1, Format: 2, Index: 3},
+		&chunkResponseMessage{Height: 1, Format: 2, Index: 3, Chunk: []byte{4}},
+		&chunkResponseMessage{Height: 1, Format: 2, Index: 3, Missing: true},
+	}
+	for _, msg := range msgs {
+		decoded, err := decodeMsg(cdc.MustMarshalBinaryBare(msg))
+		require.NoError(t, err)
+		assert.Equal(t, msg, decoded)
+	}
+
+	_, err := decodeMsg(make([]byte, maxMsgSize+1))
+	assert.Error(t, err)
+}
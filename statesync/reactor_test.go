@@ -0,0 +1,125 @@
+package statesync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func setupReactor(t *testing.T, app *testApp) *Reactor {
+	r := NewReactor(*config.DefaultStateSyncConfig(), app, app)
+	r.SetLogger(log.TestingLogger())
+	err := r.Start()
+	require.NoError(t, err)
+	return r
+}
+
+func TestReactor_Receive_ChunkRequestMessage(t *testing.T) {
+	testcases := map[string]struct {
+		request   *chunkRequestMessage
+		chunk     []byte
+		expectMsg *chunkResponseMessage
+	}{
+		"chunk is returned": {
+			&chunkRequestMessage{Height: 1, Format: 1, Index: 1},
+			[]byte{1, 2, 3},
+			&chunkResponseMessage{Height: 1, Format: 1, Index: 1, Chunk: []byte{1, 2, 3}}},
+		"empty chunk is returned, as nil": {
+			&chunkRequestMessage{Height: 1, Format: 1, Index: 1},
+			[]byte{},
+			&chunkResponseMessage{Height: 1, Format: 1, Index: 1, Chunk: nil}},
+		"nil (missing) chunk is returned as missing": {
+			&chunkRequestMessage{Height: 1, Format: 1, Index: 1},
+			nil,
+			&chunkResponseMessage{Height: 1, Format: 1, Index: 1, Missing: true},
+		},
+	}
+
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			app := &testApp{chunks: map[uint32][]byte{tc.request.Index: tc.chunk}}
+			r := setupReactor(t, app)
+			defer r.Stop()
+
+			peer := newTestPeer()
+			r.Receive(ChunkChannel, peer, cdc.MustMarshalBinaryBare(tc.request))
+			assert.Equal(t, []Message{tc.expectMsg}, peer.Sent())
+		})
+	}
+}
+
+func TestReactor_Receive_SnapshotsRequestMessage(t *testing.T) {
+	testcases := map[string]struct {
+		snapshots       []*abci.Snapshot
+		expectResponses []*snapshotsResponseMessage
+	}{
+		"no snapshots": {nil, []*snapshotsResponseMessage{}},
+		">10 unordered snapshots": {
+			[]*abci.Snapshot{
+				{Height: 1, Format: 2, Chunks: 7, Hash: []byte{1, 2}, Metadata: []byte{1}},
+				{Height: 2, Format: 2, Chunks: 7, Hash: []byte{2, 2}, Metadata: []byte{2}},
+				{Height: 3, Format: 2, Chunks: 7, Hash: []byte{3, 2}, Metadata: []byte{3}},
+				{Height: 1, Format: 1, Chunks: 7, Hash: []byte{1, 1}, Metadata: []byte{4}},
+				{Height: 2, Format: 1, Chunks: 7, Hash: []byte{2, 1}, Metadata: []byte{5}},
+				{Height: 3, Format: 1, Chunks: 7, Hash: []byte{3, 1}, Metadata: []byte{6}},
+				{Height: 1, Format: 4, Chunks: 7, Hash: []byte{1, 4}, Metadata: []byte{7}},
+				{Height: 2, Format: 4, Chunks: 7, Hash: []byte{2, 4}, Metadata: []byte{8}},
+				{Height: 3, Format: 4, Chunks: 7, Hash: []byte{3, 4}, Metadata: []byte{9}},
+				{Height: 1, Format: 3, Chunks: 7, Hash: []byte{1, 3}, Metadata: []byte{10}},
+				{Height: 2, Format: 3, Chunks: 7, Hash: []byte{2, 3}, Metadata: []byte{11}},
+				{Height: 3, Format: 3, Chunks: 7, Hash: []byte{3, 3}, Metadata: []byte{12}},
+			},
+			[]*snapshotsResponseMessage{
+				{Height: 3, Format: 4, Chunks: 7, Hash: []byte{3, 4}, Metadata: []byte{9}},
+				{Height: 3, Format: 3, Chunks: 7, Hash: []byte{3, 3}, Metadata: []byte{12}},
+				{Height: 3, Format: 2, Chunks: 7, Hash: []byte{3, 2}, Metadata: []byte{3}},
+				{Height: 3, Format: 1, Chunks: 7, Hash: []byte{3, 1}, Metadata: []byte{6}},
+				{Height: 2, Format: 4, Chunks: 7, Hash: []byte{2, 4}, Metadata: []byte{8}},
+				{Height: 2, Format: 3, Chunks: 7, Hash: []byte{2, 3}, Metadata: []byte{11}},
+				{Height: 2, Format: 2, Chunks: 7, Hash: []byte{2, 2}, Metadata: []byte{2}},
+				{Height: 2, Format: 1, Chunks: 7, Hash: []byte{2, 1}, Metadata: []byte{5}},
+				{Height: 1, Format: 4, Chunks: 7, Hash: []byte{1, 4}, Metadata: []byte{7}},
+				{Height: 1, Format: 3, Chunks: 7, Hash: []byte{1, 3}, Metadata: []byte{10}},
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			app := &testApp{snapshots: tc.snapshots}
+			r := setupReactor(t, app)
+			defer r.Stop()
+
+			peer := newTestPeer()
+			r.Receive(SnapshotChannel, peer, cdc.MustMarshalBinaryBare(&snapshotsRequestMessage{}))
+
+			expect := make([]Message, 0, len(tc.expectResponses))
+			for _, msg := range tc.expectResponses {
+				expect = append(expect, msg)
+			}
+			assert.Equal(t, expect, peer.Sent())
+		})
+	}
+}
+
+func TestReactor_Receive_unexpectedResponses(t *testing.T) {
+	app := &testApp{}
+	r := setupReactor(t, app)
+	defer r.Stop()
+
+	// Without a state sync in progress, snapshots and chunks are ignored.
+	peer := newTestPeer()
+	r.Receive(SnapshotChannel, peer, cdc.MustMarshalBinaryBare(
+		&snapshotsResponseMessage{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}}))
+	r.Receive(ChunkChannel, peer, cdc.MustMarshalBinaryBare(
+		&chunkResponseMessage{Height: 1, Format: 1, Index: 0, Chunk: []byte{1}}))
+	assert.Empty(t, peer.Sent())
+	assert.Empty(t, app.offered)
+}
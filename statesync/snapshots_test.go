@@ -0,0 +1,281 @@
+package statesync
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/p2p"
+)
+
+func TestSnapshot_Key(t *testing.T) {
+	testcases := map[string]struct {
+		modify func(*snapshot)
+	}{
+		"new height":      {func(s *snapshot) { s.Height = 9 }},
+		"new format":      {func(s *snapshot) { s.Format = 9 }},
+		"new chunk count": {func(s *snapshot) { s.Chunks = 9 }},
+		"new hash":        {func(s *snapshot) { s.Hash = []byte{9} }},
+		"no metadata":     {func(s *snapshot) { s.Metadata = nil }},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			s := snapshot{
+				Height:   3,
+				Format:   1,
+				Chunks:   7,
+				Hash:     []byte{1, 2, 3},
+				Metadata: []byte{255},
+			}
+			before := s.Key()
+			tc.modify(&s)
+			after := s.Key()
+			assert.NotEqual(t, before, after)
+		})
+	}
+}
+
+func newTestSnapshotPool() *snapshotPool {
+	appHashes := make(map[uint64][]byte)
+	for h := uint64(1); h <= 10; h++ {
+		appHashes[h] = []byte{byte(h)}
+	}
+	return newSnapshotPool(&testStateProvider{appHashes: appHashes})
+}
+
+func TestSnapshotPool_Add(t *testing.T) {
+	pool := newTestSnapshotPool()
+	peer := newTestPeer()
+
+	// Adding to the pool should work, and set the trusted app hash
+	added, err := pool.Add(peer, &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// Adding again from a different peer should return false
+	otherPeer := newTestPeer()
+	added, err = pool.Add(otherPeer, &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	// The pool should have populated the snapshot with the trusted app hash, and both peers
+	best := pool.Best()
+	require.NotNil(t, best)
+	assert.Equal(t, []byte{1}, best.trustedAppHash)
+	assert.Len(t, pool.GetPeers(best), 2)
+
+	// Adding a snapshot at an unverifiable height should fail
+	_, err = pool.Add(peer, &snapshot{Height: 99, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.Error(t, err)
+}
+
+func TestSnapshotPool_Add_stateProviderError(t *testing.T) {
+	pool := newSnapshotPool(&testStateProvider{err: errors.New("failure")})
+	_, err := pool.Add(newTestPeer(), &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.Error(t, err)
+	assert.Nil(t, pool.Best())
+}
+
+func TestSnapshotPool_Add_peerLimit(t *testing.T) {
+	pool := newTestSnapshotPool()
+	peer := newTestPeer()
+
+	for h := uint64(1); h <= recentSnapshots; h++ {
+		added, err := pool.Add(peer, &snapshot{Height: h, Format: 1, Chunks: 1, Hash: []byte{1}})
+		require.NoError(t, err)
+		assert.True(t, added)
+	}
+	added, err := pool.Add(peer, &snapshot{Height: 1, Format: 2, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Len(t, pool.Ranked(), recentSnapshots)
+}
+
+func TestSnapshotPool_GetPeer(t *testing.T) {
+	pool := newTestSnapshotPool()
+	s := &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}}
+	peerA := newTestPeer()
+	peerB := newTestPeer()
+
+	// Returns nil if the snapshot is not known
+	assert.Nil(t, pool.GetPeer(s))
+
+	_, err := pool.Add(peerA, s)
+	require.NoError(t, err)
+	_, err = pool.Add(peerB, s)
+	require.NoError(t, err)
+
+	// Sample a bunch of times, and check that both peers are returned.
+	seen := map[p2p.ID]bool{}
+	for i := 0; i < 100; i++ {
+		peer := pool.GetPeer(s)
+		require.NotNil(t, peer)
+		seen[peer.ID()] = true
+	}
+	assert.Len(t, seen, 2)
+}
+
+func TestSnapshotPool_Ranked_Best(t *testing.T) {
+	pool := newTestSnapshotPool()
+	peers := []p2p.Peer{newTestPeer(), newTestPeer(), newTestPeer()}
+
+	// Snapshots are ranked by height, then format, then the number of peers.
+	expectSnapshots := []struct {
+		snapshot *snapshot
+		peers    []p2p.Peer
+	}{
+		{&snapshot{Height: 2, Format: 2, Chunks: 4, Hash: []byte{1, 3}}, peers},
+		{&snapshot{Height: 2, Format: 2, Chunks: 5, Hash: []byte{1, 2}}, peers[:2]},
+		{&snapshot{Height: 2, Format: 1, Chunks: 3, Hash: []byte{1, 2}}, peers[:2]},
+		{&snapshot{Height: 1, Format: 2, Chunks: 5, Hash: []byte{1, 2}}, peers[:2]},
+		{&snapshot{Height: 1, Format: 1, Chunks: 4, Hash: []byte{1, 2}}, peers[:2]},
+	}
+
+	// Add snapshots in reverse order, to make sure the pool enforces some order.
+	for i := len(expectSnapshots) - 1; i >= 0; i-- {
+		for _, peer := range expectSnapshots[i].peers {
+			_, err := pool.Add(peer, expectSnapshots[i].snapshot)
+			require.NoError(t, err)
+		}
+	}
+
+	ranked := pool.Ranked()
+	require.Len(t, ranked, len(expectSnapshots))
+	for i := range ranked {
+		assert.Equal(t, expectSnapshots[i].snapshot.Key(), ranked[i].Key())
+	}
+	assert.Equal(t, expectSnapshots[0].snapshot.Key(), pool.Best().Key())
+
+	// Rejecting the best snapshot should make the next one the best.
+	pool.Reject(pool.Best())
+	assert.Equal(t, expectSnapshots[1].snapshot.Key(), pool.Best().Key())
+}
+
+func TestSnapshotPool_Reject(t *testing.T) {
+	pool := newTestSnapshotPool()
+	peer := newTestPeer()
+
+	snapshots := []*snapshot{
+		{Height: 2, Format: 2, Chunks: 1, Hash: []byte{1, 2}},
+		{Height: 2, Format: 1, Chunks: 1, Hash: []byte{1, 2}},
+		{Height: 1, Format: 2, Chunks: 1, Hash: []byte{1, 2}},
+		{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1, 2}},
+	}
+	for _, s := range snapshots {
+		_, err := pool.Add(peer, s)
+		require.NoError(t, err)
+	}
+
+	pool.Reject(snapshots[0])
+	assert.Equal(t, snapshots[1:], pool.Ranked())
+
+	added, err := pool.Add(peer, snapshots[0])
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	added, err = pool.Add(peer, &snapshot{Height: 3, Format: 3, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.True(t, added)
+}
+
+func TestSnapshotPool_RejectFormat(t *testing.T) {
+	pool := newTestSnapshotPool()
+	peer := newTestPeer()
+
+	snapshots := []*snapshot{
+		{Height: 2, Format: 2, Chunks: 1, Hash: []byte{1, 2}},
+		{Height: 2, Format: 1, Chunks: 1, Hash: []byte{1, 2}},
+		{Height: 1, Format: 2, Chunks: 1, Hash: []byte{1, 2}},
+		{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1, 2}},
+	}
+	for _, s := range snapshots {
+		_, err := pool.Add(peer, s)
+		require.NoError(t, err)
+	}
+
+	pool.RejectFormat(1)
+	assert.Equal(t, []*snapshot{snapshots[0], snapshots[2]}, pool.Ranked())
+
+	added, err := pool.Add(peer, &snapshot{Height: 3, Format: 1, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Equal(t, []*snapshot{snapshots[0], snapshots[2]}, pool.Ranked())
+
+	added, err = pool.Add(peer, &snapshot{Height: 3, Format: 3, Chunks: 1, Hash: []byte{1}})
+	require.NoError(t, err)
+	assert.True(t, added)
+}
+
+func TestSnapshotPool_RejectPeer(t *testing.T) {
+	pool := newTestSnapshotPool()
+	peerA := newTestPeer()
+	peerB := newTestPeer()
+
+	s1 := &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}}
+	s2 := &snapshot{Height: 2, Format: 1, Chunks: 1, Hash: []byte{2}}
+	s3 := &snapshot{Height: 3, Format: 1, Chunks: 1, Hash: []byte{2}}
+
+	_, err := pool.Add(peerA, s1)
+	require.NoError(t, err)
+	_, err = pool.Add(peerA, s2)
+	require.NoError(t, err)
+
+	_, err = pool.Add(peerB, s2)
+	require.NoError(t, err)
+	_, err = pool.Add(peerB, s3)
+	require.NoError(t, err)
+
+	pool.RejectPeer(peerA.ID())
+
+	assert.Empty(t, pool.GetPeers(s1))
+
+	peers2 := pool.GetPeers(s2)
+	assert.Len(t, peers2, 1)
+	assert.EqualValues(t, peerB.ID(), peers2[0].ID())
+
+	peers3 := pool.GetPeers(s3)
+	assert.Len(t, peers3, 1)
+	assert.EqualValues(t, peerB.ID(), peers3[0].ID())
+
+	// it should no longer be possible to add the peer back
+	added, err := pool.Add(peerA, s1)
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Empty(t, pool.GetPeers(s1))
+}
+
+func TestSnapshotPool_RemovePeer(t *testing.T) {
+	pool := newTestSnapshotPool()
+	peerA := newTestPeer()
+	peerB := newTestPeer()
+
+	s1 := &snapshot{Height: 1, Format: 1, Chunks: 1, Hash: []byte{1}}
+	s2 := &snapshot{Height: 2, Format: 1, Chunks: 1, Hash: []byte{2}}
+
+	_, err := pool.Add(peerA, s1)
+	require.NoError(t, err)
+	_, err = pool.Add(peerA, s2)
+	require.NoError(t, err)
+	_, err = pool.Add(peerB, s1)
+	require.NoError(t, err)
+
+	pool.RemovePeer(peerA.ID())
+
+	peers1 := pool.GetPeers(s1)
+	assert.Len(t, peers1, 1)
+	assert.EqualValues(t, peerB.ID(), peers1[0].ID())
+
+	peers2 := pool.GetPeers(s2)
+	assert.Empty(t, peers2)
+
+	// it should still be possible to add the peer back
+	added, err := pool.Add(peerA, s2)
+	require.NoError(t, err)
+	assert.True(t, added)
+	peers2 = pool.GetPeers(s2)
+	assert.Len(t, peers2, 1)
+	assert.EqualValues(t, peerA.ID(), peers2[0].ID())
+}
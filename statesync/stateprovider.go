@@ -0,0 +1,253 @@
+package statesync
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/libs/log"
+	lite "github.com/tendermint/tendermint/lite2"
+	"github.com/tendermint/tendermint/lite2/provider"
+	httpp "github.com/tendermint/tendermint/lite2/provider/http"
+	dbs "github.com/tendermint/tendermint/lite2/store/db"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// StateProvider is a provider of trusted state data for bootstrapping a node. This refers
+// to the state.State object, not the state machine.
+type StateProvider interface {
+	// AppHash returns the app hash after the given height has been committed.
+	AppHash(height uint64) ([]byte, error)
+	// Commit returns the commit at the given height.
+	Commit(height uint64) (*types.Commit, error)
+	// State returns a state object at the given height.
+	State(height uint64) (sm.State, error)
+}
+
+// lightClientStateProvider is a state provider using the light client.
+type lightClientStateProvider struct {
+	mtx sync.Mutex // the light client is reset by verifiedHeaders
+
+	chainID      string
+	version      sm.Version
+	trustOptions lite.TrustOptions
+	primary      provider.Provider
+	witnesses    []provider.Provider
+	primaryRPC   *rpcclient.HTTP
+	lc           *lite.Client
+	logger       log.Logger
+}
+
+// NewLightClientStateProvider creates a new StateProvider using a light client and RPC clients.
+// The first server is the primary, used to fetch the consensus params, the others are witnesses
+// cross-checking the headers of the primary.
+func NewLightClientStateProvider(
+	chainID string,
+	version sm.Version,
+	servers []string,
+	trustOptions lite.TrustOptions,
+	logger log.Logger,
+) (StateProvider, error) {
+	if len(servers) < 2 {
+		return nil, errors.Errorf("at least 2 RPC servers are required, got %v", len(servers))
+	}
+
+	var (
+		primaryRPC *rpcclient.HTTP
+		providers  = make([]provider.Provider, 0, len(servers))
+	)
+	for i, server := range servers {
+		client, err := rpcClient(server)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to set up RPC client for %v", server)
+		}
+		if i == 0 {
+			primaryRPC = client
+		}
+		providers = append(providers, httpp.NewWithClient(chainID, client))
+	}
+
+	s := &lightClientStateProvider{
+		chainID:      chainID,
+		version:      version,
+		trustOptions: trustOptions,
+		primary:      providers[0],
+		witnesses:    providers[1:],
+		primaryRPC:   primaryRPC,
+		logger:       logger,
+	}
+	if err := s.resetLiteClient(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// AppHash implements StateProvider.
+func (s *lightClientStateProvider) AppHash(height uint64) ([]byte, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// The app hash after the height is committed is in the header of the next height.
+	_, nextHeader, err := s.verifiedHeaders(int64(height))
+	if err != nil {
+		return nil, err
+	}
+	return nextHeader.AppHash, nil
+}
+
+// Commit implements StateProvider.
+func (s *lightClientStateProvider) Commit(height uint64) (*types.Commit, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	header, _, err := s.verifiedHeaders(int64(height))
+	if err != nil {
+		return nil, err
+	}
+	return header.Commit, nil
+}
+
+// State implements StateProvider.
+func (s *lightClientStateProvider) State(height uint64) (sm.State, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	header, nextHeader, err := s.verifiedHeaders(int64(height))
+	if err != nil {
+		return sm.State{}, err
+	}
+
+	state := sm.State{
+		ChainID: s.chainID,
+		Version: s.version,
+
+		LastBlockHeight: header.Height,
+		LastBlockID:     header.Commit.BlockID,
+		LastBlockTime:   header.Time,
+
+		AppHash:         nextHeader.AppHash,
+		LastResultsHash: nextHeader.LastResultsHash,
+
+		// The validator sets are saved in full when bootstrapping the state.
+		LastHeightValidatorsChanged:      nextHeader.Height + 1,
+		LastHeightConsensusParamsChanged: nextHeader.Height,
+	}
+
+	// The validator sets are fetched from the primary and checked against the verified headers.
+	if state.LastValidators, err = s.validatorSet(header.Height, header.ValidatorsHash); err != nil {
+		return sm.State{}, err
+	}
+	if state.Validators, err = s.validatorSet(nextHeader.Height, nextHeader.ValidatorsHash); err != nil {
+		return sm.State{}, err
+	}
+	if state.NextValidators, err = s.validatorSet(nextHeader.Height+1, nextHeader.NextValidatorsHash); err != nil {
+		return sm.State{}, err
+	}
+
+	// So are the consensus params, fetched via RPC.
+	result, err := s.primaryRPC.ConsensusParams(&nextHeader.Height)
+	if err != nil {
+		return sm.State{}, errors.Wrapf(err, "failed to fetch consensus params for height %v",
+			nextHeader.Height)
+	}
+	if !bytes.Equal(result.ConsensusParams.Hash(), nextHeader.ConsensusHash) {
+		return sm.State{}, errors.Errorf("consensus params hash %X does not match trusted hash %X",
+			result.ConsensusParams.Hash(), nextHeader.ConsensusHash)
+	}
+	state.ConsensusParams = result.ConsensusParams
+
+	return state, nil
+}
+
+// validatorSet fetches the validator set at the given height from the primary, and checks it
+// against the given trusted hash.
+func (s *lightClientStateProvider) validatorSet(height int64, hash []byte) (*types.ValidatorSet, error) {
+	vals, err := s.primary.ValidatorSet(height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch validator set for height %v", height)
+	}
+	if !bytes.Equal(vals.Hash(), hash) {
+		return nil, errors.Errorf("validator set hash %X at height %v does not match trusted hash %X",
+			vals.Hash(), height, hash)
+	}
+	return vals, nil
+}
+
+// verifiedHeaders returns the light client-verified headers at the given and the next height. The
+// caller must hold the mutex lock.
+func (s *lightClientStateProvider) verifiedHeaders(height int64) (*types.SignedHeader, *types.SignedHeader, error) {
+	header, err := s.verifiedHeader(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	nextHeader, err := s.verifiedHeader(height + 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return header, nextHeader, nil
+}
+
+// verifiedHeader returns the light client-verified header at the given height. The light client
+// can only verify headers above its latest trusted header, so it is reset to the trust options to
+// verify lower heights. The caller must hold the mutex lock.
+func (s *lightClientStateProvider) verifiedHeader(height int64) (*types.SignedHeader, error) {
+	if height < s.trustOptions.Height {
+		return nil, errors.Errorf("height %v is below the trust height %v", height, s.trustOptions.Height)
+	}
+	now := time.Now()
+	if header, err := s.lc.TrustedHeader(height, now); err == nil {
+		return header, nil
+	}
+
+	lastHeight, err := s.lc.LastTrustedHeight()
+	if err != nil {
+		return nil, err
+	}
+	if height < lastHeight {
+		if err := s.resetLiteClient(); err != nil {
+			return nil, err
+		}
+	}
+	header, err := s.lc.VerifyHeaderAtHeight(height, now)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to verify header at height %v", height)
+	}
+	return header, nil
+}
+
+// resetLiteClient replaces the light client by a new one, initialized with the trust options and
+// an empty store. The caller must hold the mutex lock.
+func (s *lightClientStateProvider) resetLiteClient() error {
+	if s.lc != nil {
+		s.lc.Stop()
+	}
+	lc, err := lite.NewClient(
+		s.chainID,
+		s.trustOptions,
+		s.primary,
+		dbs.New(dbm.NewMemDB(), s.chainID),
+		lite.AlternativeSources(s.witnesses),
+		lite.UpdatePeriod(0),
+		lite.RemoveNoLongerTrustedHeadersPeriod(0),
+		lite.Logger(s.logger),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to set up light client")
+	}
+	s.lc = lc
+	return nil
+}
+
+// rpcClient sets up a new RPC client, defaulting to the http scheme.
+func rpcClient(server string) (*rpcclient.HTTP, error) {
+	if !strings.Contains(server, "://") {
+		server = "http://" + server
+	}
+	return rpcclient.NewHTTP(server, "/websocket")
+}
@@ -0,0 +1,280 @@
+package statesync
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/mock"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+)
+
+//----------------------------------------------
+// test helpers
+
+// testPeer is a mock peer which records sent messages, and optionally calls onSend for them.
+type testPeer struct {
+	*mock.Peer
+
+	mtx    sync.Mutex
+	sent   []Message
+	onSend func(Message)
+}
+
+func newTestPeer() *testPeer {
+	return &testPeer{Peer: mock.NewPeer(nil)}
+}
+
+func (p *testPeer) Send(chID byte, msgBytes []byte) bool {
+	msg, err := decodeMsg(msgBytes)
+	if err != nil {
+		panic(err)
+	}
+	p.mtx.Lock()
+	p.sent = append(p.sent, msg)
+	onSend := p.onSend
+	p.mtx.Unlock()
+	if onSend != nil {
+		go onSend(msg)
+	}
+	return true
+}
+
+func (p *testPeer) Sent() []Message {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return append([]Message{}, p.sent...)
+}
+
+// testStateProvider is a StateProvider returning fixed app hashes, states and commits.
+type testStateProvider struct {
+	appHashes map[uint64][]byte
+	err       error
+}
+
+func (p *testStateProvider) AppHash(height uint64) ([]byte, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	appHash, ok := p.appHashes[height]
+	if !ok {
+		return nil, errors.Errorf("no app hash for height %v", height)
+	}
+	return appHash, nil
+}
+
+func (p *testStateProvider) Commit(height uint64) (*types.Commit, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &types.Commit{Height: int64(height)}, nil
+}
+
+func (p *testStateProvider) State(height uint64) (sm.State, error) {
+	if p.err != nil {
+		return sm.State{}, p.err
+	}
+	return sm.State{
+		ChainID:         "test-chain",
+		LastBlockHeight: int64(height),
+		AppHash:         p.appHashes[height],
+	}, nil
+}
+
+// testApp implements proxy.AppConnSnapshot and proxy.AppConnQuery, restoring snapshots by
+// recording the applied chunks.
+type testApp struct {
+	mtx       sync.Mutex
+	snapshots []*abci.Snapshot
+	chunks    map[uint32][]byte
+
+	offerResult abci.ResponseOfferSnapshot_Result
+	applyResult abci.ResponseApplySnapshotChunk_Result
+	applied     [][]byte
+	offered     []*abci.Snapshot
+
+	info abci.ResponseInfo
+}
+
+func (a *testApp) Error() error { return nil }
+
+func (a *testApp) ListSnapshotsSync(req abci.RequestListSnapshots) (*abci.ResponseListSnapshots, error) {
+	return &abci.ResponseListSnapshots{Snapshots: a.snapshots}, nil
+}
+
+func (a *testApp) OfferSnapshotSync(req abci.RequestOfferSnapshot) (*abci.ResponseOfferSnapshot, error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.offered = append(a.offered, req.Snapshot)
+	return &abci.ResponseOfferSnapshot{Result: a.offerResult}, nil
+}
+
+func (a *testApp) LoadSnapshotChunkSync(
+	req abci.RequestLoadSnapshotChunk) (*abci.ResponseLoadSnapshotChunk, error) {
+	return &abci.ResponseLoadSnapshotChunk{Chunk: a.chunks[req.Chunk]}, nil
+}
+
+func (a *testApp) ApplySnapshotChunkSync(
+	req abci.RequestApplySnapshotChunk) (*abci.ResponseApplySnapshotChunk, error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.applied = append(a.applied, req.Chunk)
+	return &abci.ResponseApplySnapshotChunk{Result: a.applyResult}, nil
+}
+
+func (a *testApp) EchoSync(msg string) (*abci.ResponseEcho, error) {
+	return &abci.ResponseEcho{Message: msg}, nil
+}
+
+func (a *testApp) InfoSync(req abci.RequestInfo) (*abci.ResponseInfo, error) {
+	return &a.info, nil
+}
+
+func (a *testApp) QuerySync(req abci.RequestQuery) (*abci.ResponseQuery, error) {
+	return &abci.ResponseQuery{}, nil
+}
+
+// setupSyncer sets up a syncer with a test app restoring a 3-chunk snapshot at height 1, and a
+// peer serving the chunks.
+func setupSyncer(t *testing.T) (*syncer, *testApp, *testPeer, *snapshot) {
+	app := &testApp{
+		offerResult: abci.ResponseOfferSnapshot_ACCEPT,
+		applyResult: abci.ResponseApplySnapshotChunk_ACCEPT,
+		info: abci.ResponseInfo{
+			LastBlockHeight:  1,
+			LastBlockAppHash: []byte("app_hash"),
+			AppVersion:       9,
+		},
+	}
+	stateProvider := &testStateProvider{appHashes: map[uint64][]byte{1: []byte("app_hash")}}
+	s := newSyncer(log.TestingLogger(), app, app, stateProvider, "", 2, time.Second)
+
+	peer := newTestPeer()
+	peer.onSend = func(msg Message) {
+		if msg, ok := msg.(*chunkRequestMessage); ok {
+			_, err := s.AddChunk(&chunk{
+				Height: msg.Height,
+				Format: msg.Format,
+				Index:  msg.Index,
+				Chunk:  []byte{byte(msg.Index)},
+				Sender: peer.ID(),
+			})
+			if err != nil {
+				t.Log(err)
+			}
+		}
+	}
+
+	s1 := &snapshot{Height: 1, Format: 1, Chunks: 3, Hash: []byte{1, 2, 3}}
+	added, err := s.AddSnapshot(peer, s1)
+	require.NoError(t, err)
+	require.True(t, added)
+
+	return s, app, peer, s1
+}
+
+//----------------------------------------------
+
+func TestSyncer_SyncAny(t *testing.T) {
+	s, app, _, _ := setupSyncer(t)
+
+	state, commit, err := s.SyncAny(0)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 1, state.LastBlockHeight)
+	assert.Equal(t, []byte("app_hash"), state.AppHash)
+	assert.Equal(t, version.Protocol(9), state.Version.Consensus.App)
+	assert.EqualValues(t, 1, commit.Height)
+	assert.Equal(t, [][]byte{{0}, {1}, {2}}, app.applied)
+}
+
+func TestSyncer_SyncAny_noSnapshots(t *testing.T) {
+	stateProvider := &testStateProvider{}
+	app := &testApp{}
+	s := newSyncer(log.TestingLogger(), app, app, stateProvider, "", 2, time.Second)
+
+	_, _, err := s.SyncAny(0)
+	assert.Equal(t, errNoSnapshots, err)
+}
+
+func TestSyncer_SyncAny_rejectSnapshot(t *testing.T) {
+	testcases := map[string]struct {
+		result abci.ResponseOfferSnapshot_Result
+		expect error
+	}{
+		"reject":        {abci.ResponseOfferSnapshot_REJECT, errNoSnapshots},
+		"reject format": {abci.ResponseOfferSnapshot_REJECT_FORMAT, errNoSnapshots},
+		"reject sender": {abci.ResponseOfferSnapshot_REJECT_SENDER, errNoSnapshots},
+		"abort":         {abci.ResponseOfferSnapshot_ABORT, errAbort},
+		"unknown":       {abci.ResponseOfferSnapshot_UNKNOWN, nil},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			s, app, peer, s1 := setupSyncer(t)
+			app.offerResult = tc.result
+
+			_, _, err := s.SyncAny(0)
+			require.Error(t, err)
+			if tc.expect != nil {
+				assert.Equal(t, tc.expect, errors.Cause(err))
+			}
+			assert.Len(t, app.offered, 1)
+			assert.Empty(t, app.applied)
+
+			// Rejected snapshots and senders can't be added again.
+			if tc.expect == errNoSnapshots {
+				added, err := s.AddSnapshot(peer, &snapshot{Height: 1, Format: 1, Chunks: 3, Hash: s1.Hash})
+				require.NoError(t, err)
+				assert.False(t, added)
+			}
+		})
+	}
+}
+
+func TestSyncer_SyncAny_verifyFailed(t *testing.T) {
+	s, app, _, _ := setupSyncer(t)
+	app.info.LastBlockAppHash = []byte("wrong")
+
+	_, _, err := s.SyncAny(0)
+	require.Error(t, err)
+	assert.Equal(t, errVerifyFailed, errors.Cause(err))
+	assert.Len(t, app.applied, 3)
+}
+
+func TestSyncer_SyncAny_applyAbort(t *testing.T) {
+	s, app, _, _ := setupSyncer(t)
+	app.applyResult = abci.ResponseApplySnapshotChunk_ABORT
+
+	_, _, err := s.SyncAny(0)
+	assert.Equal(t, errAbort, errors.Cause(err))
+	assert.Len(t, app.applied, 1)
+}
+
+func TestSyncer_AddPeer(t *testing.T) {
+	stateProvider := &testStateProvider{}
+	app := &testApp{}
+	s := newSyncer(log.TestingLogger(), app, app, stateProvider, "", 2, time.Second)
+
+	peer := newTestPeer()
+	s.AddPeer(peer)
+	assert.Equal(t, []Message{&snapshotsRequestMessage{}}, peer.Sent())
+}
+
+func TestSyncer_AddChunk_noSync(t *testing.T) {
+	s, _, peer, _ := setupSyncer(t)
+
+	_, err := s.AddChunk(&chunk{Height: 1, Format: 1, Index: 0, Chunk: []byte{0}, Sender: peer.ID()})
+	assert.Error(t, err)
+}
+
+var _ p2p.Peer = (*testPeer)(nil)
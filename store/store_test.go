@@ -66,7 +66,7 @@ func makeStateAndBlockStore(logger log.Logger) (sm.State, *BlockStore, cleanupFu
 func TestLoadBlockStoreStateJSON(t *testing.T) {
 	db := db.NewMemDB()
 
-	bsj := &BlockStoreStateJSON{Height: 1000}
+	bsj := &BlockStoreStateJSON{Base: 100, Height: 1000}
 	bsj.Save(db)
 
 	retrBSJ := LoadBlockStoreStateJSON(db)
@@ -74,11 +74,24 @@ func TestLoadBlockStoreStateJSON(t *testing.T) {
 	assert.Equal(t, *bsj, retrBSJ, "expected the retrieved DBs to match")
 }
 
+func TestLoadBlockStoreStateJSONWithoutBase(t *testing.T) {
+	db := db.NewMemDB()
+
+	// a block store saved before pruning starts at 1
+	bsj := &BlockStoreStateJSON{Height: 1000}
+	bsj.Save(db)
+
+	retrBSJ := LoadBlockStoreStateJSON(db)
+
+	assert.Equal(t, BlockStoreStateJSON{Base: 1, Height: 1000}, retrBSJ)
+}
+
 func TestNewBlockStore(t *testing.T) {
 	db := db.NewMemDB()
 	err := db.Set(blockStoreKey, []byte(`{"height": "10000"}`))
 	require.NoError(t, err)
 	bs := NewBlockStore(db)
+	require.Equal(t, int64(1), bs.Base(), "failed to properly parse blockstore")
 	require.Equal(t, int64(10000), bs.Height(), "failed to properly parse blockstore")
 
 	panicCausers := []struct {
@@ -157,6 +170,13 @@ func TestBlockStoreSaveLoadBlock(t *testing.T) {
 	bs.SaveBlock(block, partSet, seenCommit)
 	require.Equal(t, bs.Height(), block.Header.Height, "expecting the new height to be changed")
 
+	// a non-empty store only accepts the next height
+	uncontiguousBlock := makeBlock(bs.Height()+2, state, new(types.Commit))
+	require.PanicsWithValue(t, fmt.Sprintf("BlockStore can only save contiguous blocks. Wanted %v, got %v",
+		bs.Height()+1, bs.Height()+2), func() {
+		bs.SaveBlock(uncontiguousBlock, uncontiguousBlock.MakePartSet(2), seenCommit)
+	})
+
 	incompletePartSet := types.NewPartSetFromHeader(types.PartSetHeader{Total: 2})
 	uncontiguousPartSet := types.NewPartSetFromHeader(types.PartSetHeader{Total: 0})
 	uncontiguousPartSet.AddPart(part2)
@@ -198,8 +218,8 @@ func TestBlockStoreSaveLoadBlock(t *testing.T) {
 
 		{
 			block:     newBlock(header2, commitAtH10),
-			parts:     uncontiguousPartSet,
-			wantPanic: "only save contiguous blocks", // and incomplete and uncontiguous parts
+			parts:     incompletePartSet,
+			wantPanic: "only save complete block part sets", // an empty store accepts any height
 		},
 
 		{
@@ -407,6 +427,13 @@ func TestBlockFetchAtHeight(t *testing.T) {
 	bs.SaveBlock(block, partSet, seenCommit)
 	require.Equal(t, bs.Height(), block.Header.Height, "expecting the new height to be changed")
 
+	// a non-empty store only accepts the next height
+	uncontiguousBlock := makeBlock(bs.Height()+2, state, new(types.Commit))
+	require.PanicsWithValue(t, fmt.Sprintf("BlockStore can only save contiguous blocks. Wanted %v, got %v",
+		bs.Height()+1, bs.Height()+2), func() {
+		bs.SaveBlock(uncontiguousBlock, uncontiguousBlock.MakePartSet(2), seenCommit)
+	})
+
 	blockAtHeight := bs.LoadBlock(bs.Height())
 	bz1 := cdc.MustMarshalBinaryBare(block)
 	bz2 := cdc.MustMarshalBinaryBare(blockAtHeight)
@@ -420,6 +447,85 @@ func TestBlockFetchAtHeight(t *testing.T) {
 	require.Nil(t, blockAtHeightPlus2, "expecting an unsuccessful load of Height()+2")
 }
 
+func TestPruneBlocks(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+	assert.EqualValues(t, 0, bs.Base())
+	assert.EqualValues(t, 0, bs.Height())
+	assert.EqualValues(t, 0, bs.Size())
+
+	// pruning an empty store should error, even when pruning to 0
+	_, err := bs.PruneBlocks(1)
+	require.Error(t, err)
+
+	_, err = bs.PruneBlocks(0)
+	require.Error(t, err)
+
+	// make more than 1000 blocks, to test batch deletions
+	for h := int64(1); h <= 1500; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		partSet := block.MakePartSet(2)
+		seenCommit := makeTestCommit(h, tmtime.Now())
+		bs.SaveBlock(block, partSet, seenCommit)
+	}
+
+	assert.EqualValues(t, 1, bs.Base())
+	assert.EqualValues(t, 1500, bs.Height())
+	assert.EqualValues(t, 1500, bs.Size())
+
+	prunedBlock := bs.LoadBlock(1199)
+
+	// prune more than 1000 blocks, to test batch deletions
+	pruned, err := bs.PruneBlocks(1200)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1199, pruned)
+	assert.EqualValues(t, 1200, bs.Base())
+	assert.EqualValues(t, 1500, bs.Height())
+	assert.EqualValues(t, 301, bs.Size())
+	assert.EqualValues(t, BlockStoreStateJSON{Base: 1200, Height: 1500}, LoadBlockStoreStateJSON(bs.db))
+
+	require.NotNil(t, bs.LoadBlock(1200))
+	require.Nil(t, bs.LoadBlock(1199))
+	require.Nil(t, bs.LoadBlockByHash(prunedBlock.Hash()))
+	require.Nil(t, bs.LoadBlockCommit(1199))
+	require.Nil(t, bs.LoadBlockMeta(1199))
+	require.Nil(t, bs.LoadBlockPart(1199, 1))
+
+	for i := int64(1); i < 1200; i++ {
+		require.Nil(t, bs.LoadBlock(i))
+	}
+	for i := int64(1200); i <= 1500; i++ {
+		require.NotNil(t, bs.LoadBlock(i))
+	}
+
+	// pruning below the current base should error
+	_, err = bs.PruneBlocks(1199)
+	require.Error(t, err)
+
+	// pruning to the current base should work
+	pruned, err = bs.PruneBlocks(1200)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+
+	// pruning again should work
+	pruned, err = bs.PruneBlocks(1300)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, pruned)
+	assert.EqualValues(t, 1300, bs.Base())
+
+	// pruning beyond the current height should error
+	_, err = bs.PruneBlocks(1501)
+	require.Error(t, err)
+
+	// pruning to the current height should work
+	pruned, err = bs.PruneBlocks(1500)
+	require.NoError(t, err)
+	assert.EqualValues(t, 200, pruned)
+	assert.Nil(t, bs.LoadBlock(1499))
+	assert.NotNil(t, bs.LoadBlock(1500))
+	assert.Nil(t, bs.LoadBlock(1501))
+}
+
 func doFn(fn func() (interface{}, error)) (res interface{}, err error, panicErr error) {
 	defer func() {
 		if r := recover(); r != nil {
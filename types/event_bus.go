@@ -221,6 +221,19 @@ func (b *EventBus) PublishEventValidatorSetUpdates(data EventDataValidatorSetUpd
 	return b.Publish(EventValidatorSetUpdates, data)
 }
 
+// PublishEventEvictedTx publishes an evicted tx event. Note it will add the
+// predefined key TxHashKey.
+func (b *EventBus) PublishEventEvictedTx(data EventDataEvictedTx) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey: {EventEvictedTx},
+		TxHashKey:    {fmt.Sprintf("%X", data.Tx.Hash())},
+	}
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
 //-----------------------------------------------------------------------------
 type NopEventBus struct{}
 
@@ -296,3 +309,7 @@ func (NopEventBus) PublishEventLock(data EventDataRoundState) error {
 func (NopEventBus) PublishEventValidatorSetUpdates(data EventDataValidatorSetUpdates) error {
 	return nil
 }
+
+func (NopEventBus) PublishEventEvictedTx(data EventDataEvictedTx) error {
+	return nil
+}
@@ -35,6 +35,11 @@ const (
 	EventUnlock           = "Unlock"
 	EventValidBlock       = "ValidBlock"
 	EventVote             = "Vote"
+
+	// Mempool events.
+	// EvictedTx is fired when a tx is removed from the mempool before being
+	// committed, e.g. because it expired.
+	EventEvictedTx = "EvictedTx"
 )
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -57,6 +62,7 @@ func RegisterEventDatas(cdc *amino.Codec) {
 	cdc.RegisterConcrete(EventDataVote{}, "tendermint/event/Vote", nil)
 	cdc.RegisterConcrete(EventDataValidatorSetUpdates{}, "tendermint/event/ValidatorSetUpdates", nil)
 	cdc.RegisterConcrete(EventDataString(""), "tendermint/event/ProposalString", nil)
+	cdc.RegisterConcrete(EventDataEvictedTx{}, "tendermint/event/EvictedTx", nil)
 }
 
 // Most event messages are basic types (a block, a transaction)
@@ -120,6 +126,13 @@ type EventDataValidatorSetUpdates struct {
 	ValidatorUpdates []*Validator `json:"validator_updates"`
 }
 
+// EventDataEvictedTx is fired with the tx evicted from the mempool and the
+// reason of the eviction.
+type EventDataEvictedTx struct {
+	Tx     Tx     `json:"tx"`
+	Reason string `json:"reason"`
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // PUBSUB
 ///////////////////////////////////////////////////////////////////////////////
@@ -133,10 +146,14 @@ const (
 	// TxHeightKey is a reserved key, used to specify transaction block's height.
 	// see EventBus#PublishEventTx
 	TxHeightKey = "tx.height"
+	// BlockHeightKey is a reserved key, used to specify the height of a block
+	// when searching for blocks by their BeginBlock and EndBlock events.
+	BlockHeightKey = "block.height"
 )
 
 var (
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
+	EventQueryEvictedTx           = QueryForEvent(EventEvictedTx)
 	EventQueryLock                = QueryForEvent(EventLock)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
@@ -172,3 +189,8 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(EventDataTx) error
 }
+
+// MempoolEventPublisher publishes the mempool events
+type MempoolEventPublisher interface {
+	PublishEventEvictedTx(EventDataEvictedTx) error
+}
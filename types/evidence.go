@@ -13,6 +13,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/merkle"
+	tmmath "github.com/tendermint/tendermint/libs/math"
 )
 
 const (
@@ -71,6 +72,7 @@ type Evidence interface {
 func RegisterEvidences(cdc *amino.Codec) {
 	cdc.RegisterInterface((*Evidence)(nil), nil)
 	cdc.RegisterConcrete(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence", nil)
+	cdc.RegisterConcrete(&ConflictingHeadersEvidence{}, "tendermint/ConflictingHeadersEvidence", nil)
 }
 
 func RegisterMockEvidences(cdc *amino.Codec) {
@@ -246,6 +248,184 @@ func (dve *DuplicateVoteEvidence) ValidateBasic() error {
 
 //-----------------------------------------------------------------
 
+// CompositeEvidence consists of multiple pieces of evidence against individual
+// validators. It can't be verified on its own: the receiving node first checks
+// it against its own (committed) header at the same height, then splits it
+// into the individual pieces, which are verified and committed as usual.
+type CompositeEvidence interface {
+	VerifyComposite(committedHeader *Header, valSet *ValidatorSet) error
+	Split(committedHeader *Header, valSet *ValidatorSet) []Evidence
+}
+
+// ConflictingHeadersEvidence is primarily used by the light client when it
+// observes two conflicting headers, both having 1/3+ of the voting power of
+// the validator set trusted by the light client.
+type ConflictingHeadersEvidence struct {
+	H1 *SignedHeader `json:"h_1"`
+	H2 *SignedHeader `json:"h_2"`
+}
+
+var (
+	_ Evidence          = &ConflictingHeadersEvidence{}
+	_ CompositeEvidence = &ConflictingHeadersEvidence{}
+)
+
+// NewConflictingHeadersEvidence creates a new instance of the respective
+// evidence.
+func NewConflictingHeadersEvidence(h1, h2 *SignedHeader) *ConflictingHeadersEvidence {
+	return &ConflictingHeadersEvidence{H1: h1, H2: h2}
+}
+
+// Split breaks up the evidence into DuplicateVoteEvidence, one for every
+// validator (of the given validator set) who signed both the committed header
+// and the alternative one in the same round.
+//
+// Validators who signed the alternative header in a different round are not
+// punished, as there is no proof of them double signing.
+func (ev *ConflictingHeadersEvidence) Split(committedHeader *Header, valSet *ValidatorSet) []Evidence {
+	committedCommit, alternativeCommit := ev.H1.Commit, ev.H2.Commit
+	if bytes.Equal(committedHeader.Hash(), ev.H2.Hash()) {
+		committedCommit, alternativeCommit = ev.H2.Commit, ev.H1.Commit
+	}
+	if committedCommit.Round != alternativeCommit.Round {
+		return nil
+	}
+
+	evList := make([]Evidence, 0)
+	for i, sigA := range committedCommit.Signatures {
+		if !sigA.ForBlock() {
+			continue
+		}
+		valIdx, val := valSet.GetByAddress(sigA.ValidatorAddress)
+		if val == nil {
+			continue
+		}
+
+		for j, sigB := range alternativeCommit.Signatures {
+			if !sigB.ForBlock() || !bytes.Equal(sigA.ValidatorAddress, sigB.ValidatorAddress) {
+				continue
+			}
+
+			// the index is not part of the sign bytes, so both votes can use the
+			// index of the validator in the committed validator set
+			voteA, voteB := committedCommit.GetVote(i), alternativeCommit.GetVote(j)
+			voteA.ValidatorIndex, voteB.ValidatorIndex = valIdx, valIdx
+			evList = append(evList, NewDuplicateVoteEvidence(val.PubKey, voteA, voteB))
+			break
+		}
+	}
+	return evList
+}
+
+// VerifyComposite verifies that one of the headers is the committed one, and
+// that the other one is signed by 1/3+ of the validator set at that height
+// (the one which committed the header).
+func (ev *ConflictingHeadersEvidence) VerifyComposite(committedHeader *Header, valSet *ValidatorSet) error {
+	var alternativeHeader *SignedHeader
+	switch {
+	case bytes.Equal(committedHeader.Hash(), ev.H1.Hash()):
+		alternativeHeader = ev.H2
+	case bytes.Equal(committedHeader.Hash(), ev.H2.Hash()):
+		alternativeHeader = ev.H1
+	default:
+		return errors.New("none of the headers are committed from this node's perspective")
+	}
+
+	// ChainID must be the same
+	if committedHeader.ChainID != alternativeHeader.ChainID {
+		return errors.New("alt header is from a different chain")
+	}
+
+	// Height must be the same
+	if committedHeader.Height != alternativeHeader.Height {
+		return errors.New("alt header is from a different height")
+	}
+
+	// 1/3+ of the validator set must have signed the alternative header
+	err := valSet.VerifyCommitTrusting(
+		alternativeHeader.ChainID,
+		alternativeHeader.Commit.BlockID,
+		alternativeHeader.Height,
+		alternativeHeader.Commit,
+		tmmath.Fraction{Numerator: 1, Denominator: 3},
+	)
+	if err != nil {
+		return errors.Wrap(err, "alt header does not have 1/3+ of voting power of our validator set")
+	}
+
+	return nil
+}
+
+// Height returns the height of the conflicting headers.
+func (ev *ConflictingHeadersEvidence) Height() int64 { return ev.H1.Height }
+
+// Time returns the time of the first header.
+// XXX: headers at the same height can have different times.
+func (ev *ConflictingHeadersEvidence) Time() time.Time { return ev.H1.Time }
+
+// Address returns nil, as the evidence is not against a particular validator.
+// Use Split to get the individual pieces of evidence.
+func (ev *ConflictingHeadersEvidence) Address() []byte {
+	return nil
+}
+
+// Bytes returns the amino-encoded evidence.
+func (ev *ConflictingHeadersEvidence) Bytes() []byte {
+	return cdcEncode(ev)
+}
+
+// Hash returns the hash of both headers.
+func (ev *ConflictingHeadersEvidence) Hash() []byte {
+	bz := make([]byte, tmhash.Size*2)
+	copy(bz[:tmhash.Size], ev.H1.Hash())
+	copy(bz[tmhash.Size:], ev.H2.Hash())
+	return tmhash.Sum(bz)
+}
+
+// Verify does nothing. Use VerifyComposite instead.
+func (ev *ConflictingHeadersEvidence) Verify(chainID string, _ crypto.PubKey) error {
+	return nil
+}
+
+// Equal checks if two pieces of evidence are equal, i.e. contain the same two
+// headers (in any order).
+func (ev *ConflictingHeadersEvidence) Equal(ev2 Evidence) bool {
+	e2, ok := ev2.(*ConflictingHeadersEvidence)
+	if !ok {
+		return false
+	}
+	return (bytes.Equal(ev.H1.Hash(), e2.H1.Hash()) && bytes.Equal(ev.H2.Hash(), e2.H2.Hash())) ||
+		(bytes.Equal(ev.H1.Hash(), e2.H2.Hash()) && bytes.Equal(ev.H2.Hash(), e2.H1.Hash()))
+}
+
+// ValidateBasic performs basic validation.
+func (ev *ConflictingHeadersEvidence) ValidateBasic() error {
+	if ev.H1 == nil || ev.H2 == nil {
+		return fmt.Errorf("one or both of the headers are empty %v, %v", ev.H1, ev.H2)
+	}
+	if err := ev.H1.ValidateBasic(ev.H1.ChainID); err != nil {
+		return fmt.Errorf("invalid H1: %v", err)
+	}
+	if err := ev.H2.ValidateBasic(ev.H1.ChainID); err != nil {
+		return fmt.Errorf("invalid H2: %v", err)
+	}
+	if ev.H1.Height != ev.H2.Height {
+		return fmt.Errorf("headers are from different heights: %d and %d", ev.H1.Height, ev.H2.Height)
+	}
+	if bytes.Equal(ev.H1.Hash(), ev.H2.Hash()) {
+		return errors.New("headers are the same - not a conflict")
+	}
+	return nil
+}
+
+// String returns a string representation of the evidence.
+func (ev *ConflictingHeadersEvidence) String() string {
+	return fmt.Sprintf("ConflictingHeadersEvidence{H1: %d#%X, H2: %d#%X}",
+		ev.H1.Height, ev.H1.Hash(), ev.H2.Height, ev.H2.Hash())
+}
+
+//-----------------------------------------------------------------
+
 // UNSTABLE
 type MockRandomEvidence struct {
 	MockEvidence
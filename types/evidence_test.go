@@ -173,3 +173,88 @@ func TestMockBadEvidenceValidateBasic(t *testing.T) {
 	badEvidence := NewMockEvidence(int64(1), time.Now(), 1, []byte{1})
 	assert.Nil(t, badEvidence.ValidateBasic())
 }
+
+func makeSignedHeader(t *testing.T, chainID string, height int64, round int, appHash []byte,
+	valSet *ValidatorSet, privVals []PrivValidator) *SignedHeader {
+
+	header := &Header{
+		ChainID:            chainID,
+		Height:             height,
+		Time:               time.Now(),
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		AppHash:            appHash,
+	}
+	blockID := makeBlockID(header.Hash(), 1, tmhash.Sum([]byte("parts")))
+	voteSet := NewVoteSet(chainID, height, round, PrecommitType, valSet)
+	commit, err := MakeCommit(blockID, height, round, voteSet, privVals)
+	require.NoError(t, err)
+	return &SignedHeader{Header: header, Commit: commit}
+}
+
+func TestConflictingHeadersEvidence(t *testing.T) {
+	const (
+		chainID = "test_chain_id"
+		height  = int64(10)
+	)
+	valSet, privVals := RandValidatorSet(4, 10)
+
+	h1 := makeSignedHeader(t, chainID, height, 1, []byte("app_hash_1"), valSet, privVals)
+	h2 := makeSignedHeader(t, chainID, height, 1, []byte("app_hash_2"), valSet, privVals)
+	ev := NewConflictingHeadersEvidence(h1, h2)
+
+	require.NoError(t, ev.ValidateBasic())
+	assert.Equal(t, height, ev.Height())
+	assert.Nil(t, ev.Address())
+	assert.True(t, ev.Equal(NewConflictingHeadersEvidence(h2, h1)))
+	assert.False(t, ev.Equal(NewConflictingHeadersEvidence(h1, h1)))
+
+	// verify against either committed header
+	assert.NoError(t, ev.VerifyComposite(h1.Header, valSet))
+	assert.NoError(t, ev.VerifyComposite(h2.Header, valSet))
+	h3 := makeSignedHeader(t, chainID, height, 1, []byte("app_hash_3"), valSet, privVals)
+	assert.Error(t, ev.VerifyComposite(h3.Header, valSet))
+
+	// every validator signed both headers
+	evList := ev.Split(h1.Header, valSet)
+	require.Len(t, evList, 4)
+	for _, e := range evList {
+		require.NoError(t, e.ValidateBasic())
+		_, val := valSet.GetByAddress(e.Address())
+		require.NotNil(t, val)
+		assert.NoError(t, e.Verify(chainID, val.PubKey))
+	}
+
+	// the alternative header is signed by another validator set
+	otherValSet, otherPrivVals := RandValidatorSet(4, 10)
+	h4 := makeSignedHeader(t, chainID, height, 1, []byte("app_hash_4"), otherValSet, otherPrivVals)
+	assert.Error(t, NewConflictingHeadersEvidence(h1, h4).VerifyComposite(h1.Header, valSet))
+
+	// headers signed in different rounds can't be split
+	h5 := makeSignedHeader(t, chainID, height, 2, []byte("app_hash_5"), valSet, privVals)
+	assert.Empty(t, NewConflictingHeadersEvidence(h1, h5).Split(h1.Header, valSet))
+}
+
+func TestConflictingHeadersEvidenceValidateBasic(t *testing.T) {
+	const chainID = "test_chain_id"
+	valSet, privVals := RandValidatorSet(1, 10)
+	h1 := makeSignedHeader(t, chainID, 10, 1, []byte("app_hash_1"), valSet, privVals)
+	h2 := makeSignedHeader(t, chainID, 10, 1, []byte("app_hash_2"), valSet, privVals)
+
+	testCases := map[string]struct {
+		ev        *ConflictingHeadersEvidence
+		expectErr bool
+	}{
+		"valid":              {NewConflictingHeadersEvidence(h1, h2), false},
+		"missing header":     {NewConflictingHeadersEvidence(h1, nil), true},
+		"same headers":       {NewConflictingHeadersEvidence(h1, h1), true},
+		"different heights":  {NewConflictingHeadersEvidence(h1, makeSignedHeader(t, chainID, 11, 1, nil, valSet, privVals)), true},
+		"different chain ID": {NewConflictingHeadersEvidence(h1, makeSignedHeader(t, "other", 10, 1, nil, valSet, privVals)), true},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expectErr, tc.ev.ValidateBasic() != nil)
+		})
+	}
+}